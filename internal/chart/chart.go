@@ -0,0 +1,172 @@
+// Package chart decodes DrawingML chart parts (c:chartSpace) into tables of series values.
+//
+// Hancom Office stores charts as OOXML chart XML: in HWPX packages as Chart/chartN.xml
+// parts and in HWP 5.x documents inside the chart's OLE object. Each series (c:ser)
+// caches its name, category labels and values, so the numbers can be read without
+// the original spreadsheet.
+package chart
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/roboco-io/hwp2md/internal/ir"
+)
+
+// Chart is the cached data of a chart.
+type Chart struct {
+	Title      string
+	Categories []string
+	Series     []Series
+}
+
+// Series is one data series of a chart.
+type Series struct {
+	Name   string
+	Values []string
+}
+
+// Parse parses chart XML (a c:chartSpace document).
+// Namespace prefixes are ignored, so both the c: (DrawingML) and Hancom variants are read.
+func Parse(data []byte) (*Chart, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+
+	c := &Chart{}
+	var path []string // 현재 요소까지의 경로 (로컬 이름)
+	var series *Series
+	var categories []string
+	var text strings.Builder
+	idx := 0
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("chart XML parse error: %w", err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			path = append(path, t.Name.Local)
+			text.Reset()
+
+			switch t.Name.Local {
+			case "ser":
+				series = &Series{}
+				categories = nil
+			case "pt":
+				idx = 0
+				for _, attr := range t.Attr {
+					if attr.Name.Local == "idx" {
+						idx, _ = strconv.Atoi(attr.Value)
+					}
+				}
+			}
+
+		case xml.CharData:
+			text.Write(t)
+
+		case xml.EndElement:
+			value := strings.TrimSpace(text.String())
+
+			switch {
+			case t.Name.Local == "ser" && series != nil:
+				// 항목 이름은 첫 계열의 것을 쓴다 (모든 계열이 같은 항목을 공유)
+				if len(c.Categories) == 0 {
+					c.Categories = categories
+				}
+				c.Series = append(c.Series, *series)
+				series = nil
+
+			case t.Name.Local == "v" && series != nil:
+				switch {
+				case within(path, "tx"):
+					series.Name = value
+				case within(path, "cat") || within(path, "xVal"):
+					categories = setAt(categories, idx, value)
+				case within(path, "val") || within(path, "yVal"):
+					series.Values = setAt(series.Values, idx, value)
+				}
+
+			case t.Name.Local == "t" && series == nil && within(path, "title") && !within(path, "plotArea"):
+				// 축 제목(plotArea 안)이 아닌 차트 제목
+				c.Title += value
+			}
+
+			path = path[:len(path)-1]
+			text.Reset()
+		}
+	}
+
+	if len(c.Series) == 0 {
+		return nil, fmt.Errorf("chart has no series")
+	}
+	return c, nil
+}
+
+// within reports whether the element path passes through name.
+func within(path []string, name string) bool {
+	for _, p := range path {
+		if p == name {
+			return true
+		}
+	}
+	return false
+}
+
+// maxPoints is the largest number of points read per series; the point index comes
+// from the file, so values past it are dropped instead of growing the table.
+const maxPoints = 10000
+
+// setAt stores value at index idx, growing the slice as needed.
+// Indexes outside 0..maxPoints-1 are ignored.
+func setAt(values []string, idx int, value string) []string {
+	if idx < 0 || idx >= maxPoints {
+		return values
+	}
+	for len(values) <= idx {
+		values = append(values, "")
+	}
+	values[idx] = value
+	return values
+}
+
+// Table returns the chart data as a table: a header row with the series names,
+// then one row per category with the value of each series.
+func (c *Chart) Table() *ir.TableBlock {
+	rows := len(c.Categories)
+	for _, s := range c.Series {
+		rows = max(rows, len(s.Values))
+	}
+
+	table := ir.NewTable(rows+1, len(c.Series)+1)
+	for j, s := range c.Series {
+		name := s.Name
+		if name == "" {
+			name = fmt.Sprintf("계열 %d", j+1)
+		}
+		table.SetCell(0, j+1, name)
+	}
+	for i := 0; i < rows; i++ {
+		category := strconv.Itoa(i + 1)
+		if i < len(c.Categories) && c.Categories[i] != "" {
+			category = c.Categories[i]
+		}
+		table.SetCell(i+1, 0, category)
+		for j, s := range c.Series {
+			if i < len(s.Values) {
+				table.SetCell(i+1, j+1, s.Values[i])
+			}
+		}
+	}
+	table.SetHeaderRow()
+	table.Caption = c.Title
+
+	return table
+}
@@ -0,0 +1,118 @@
+package chart
+
+import "testing"
+
+const barChartXML = `<?xml version="1.0" encoding="UTF-8"?>
+<c:chartSpace xmlns:c="http://schemas.openxmlformats.org/drawingml/2006/chart"
+    xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main">
+  <c:chart>
+    <c:title><c:tx><c:rich><a:p><a:r><a:t>연도별 예산</a:t></a:r></a:p></c:rich></c:tx></c:title>
+    <c:plotArea>
+      <c:barChart>
+        <c:ser>
+          <c:idx val="0"/>
+          <c:tx><c:strRef><c:strCache><c:ptCount val="1"/><c:pt idx="0"><c:v>본예산</c:v></c:pt></c:strCache></c:strRef></c:tx>
+          <c:cat><c:strRef><c:strCache><c:ptCount val="2"/>
+            <c:pt idx="0"><c:v>2024</c:v></c:pt>
+            <c:pt idx="1"><c:v>2025</c:v></c:pt>
+          </c:strCache></c:strRef></c:cat>
+          <c:val><c:numRef><c:numCache><c:ptCount val="2"/>
+            <c:pt idx="0"><c:v>1200</c:v></c:pt>
+            <c:pt idx="1"><c:v>1350</c:v></c:pt>
+          </c:numCache></c:numRef></c:val>
+        </c:ser>
+        <c:ser>
+          <c:idx val="1"/>
+          <c:tx><c:v>추경</c:v></c:tx>
+          <c:val><c:numRef><c:numCache><c:ptCount val="2"/>
+            <c:pt idx="1"><c:v>80</c:v></c:pt>
+          </c:numCache></c:numRef></c:val>
+        </c:ser>
+      </c:barChart>
+      <c:valAx><c:title><c:tx><c:rich><a:p><a:r><a:t>억 원</a:t></a:r></a:p></c:rich></c:tx></c:title></c:valAx>
+    </c:plotArea>
+  </c:chart>
+</c:chartSpace>`
+
+func TestParse(t *testing.T) {
+	c, err := Parse([]byte(barChartXML))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if c.Title != "연도별 예산" {
+		t.Errorf("expected chart title without axis title, got %q", c.Title)
+	}
+	if len(c.Categories) != 2 || c.Categories[0] != "2024" || c.Categories[1] != "2025" {
+		t.Errorf("unexpected categories: %v", c.Categories)
+	}
+	if len(c.Series) != 2 {
+		t.Fatalf("expected 2 series, got %d", len(c.Series))
+	}
+	if c.Series[0].Name != "본예산" || len(c.Series[0].Values) != 2 || c.Series[0].Values[1] != "1350" {
+		t.Errorf("unexpected first series: %+v", c.Series[0])
+	}
+	// 빠진 점은 빈 값으로 채워진다
+	if c.Series[1].Name != "추경" || len(c.Series[1].Values) != 2 || c.Series[1].Values[0] != "" || c.Series[1].Values[1] != "80" {
+		t.Errorf("unexpected second series: %+v", c.Series[1])
+	}
+}
+
+func TestParse_NoSeries(t *testing.T) {
+	if _, err := Parse([]byte(`<c:chartSpace xmlns:c="c"><c:chart/></c:chartSpace>`)); err == nil {
+		t.Error("expected error for chart without series")
+	}
+	if _, err := Parse([]byte(`<c:chartSpace`)); err == nil {
+		t.Error("expected error for invalid XML")
+	}
+}
+
+func TestParse_PointIndexLimit(t *testing.T) {
+	data := `<c:chartSpace xmlns:c="c"><c:chart><c:plotArea><c:barChart><c:ser>
+  <c:val><c:numCache>
+    <c:pt idx="1"><c:v>10</c:v></c:pt>
+    <c:pt idx="2000000000"><c:v>20</c:v></c:pt>
+  </c:numCache></c:val>
+</c:ser></c:barChart></c:plotArea></c:chart></c:chartSpace>`
+	c, err := Parse([]byte(data))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	// 범위를 벗어난 점 번호는 버린다
+	if values := c.Series[0].Values; len(values) != 2 || values[1] != "10" {
+		t.Errorf("unexpected values: %v", values)
+	}
+}
+
+func TestChart_Table(t *testing.T) {
+	c, err := Parse([]byte(barChartXML))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	table := c.Table()
+	if table.Rows != 3 || table.Cols != 3 || !table.HasHeader {
+		t.Fatalf("expected 3x3 table with header, got %dx%d (header %v)", table.Rows, table.Cols, table.HasHeader)
+	}
+	expected := [][]string{
+		{"", "본예산", "추경"},
+		{"2024", "1200", ""},
+		{"2025", "1350", "80"},
+	}
+	for i, row := range expected {
+		for j, text := range row {
+			if got := table.Cells[i][j].Text; got != text {
+				t.Errorf("cell (%d,%d): expected %q, got %q", i, j, text, got)
+			}
+		}
+	}
+	if table.Caption != "연도별 예산" {
+		t.Errorf("expected chart title as caption, got %q", table.Caption)
+	}
+
+	// 항목과 계열 이름이 없으면 번호를 붙인다
+	unnamed := (&Chart{Series: []Series{{Values: []string{"1", "2"}}}}).Table()
+	if unnamed.Cells[0][1].Text != "계열 1" || unnamed.Cells[2][0].Text != "2" {
+		t.Errorf("unexpected unnamed chart table: %+v", unnamed.Cells)
+	}
+}
@@ -2,7 +2,10 @@ package cli
 
 import (
 	"os"
+	"strings"
 	"testing"
+
+	"github.com/roboco-io/hwp2md/internal/ir"
 )
 
 func TestSetVersion(t *testing.T) {
@@ -106,7 +109,7 @@ func TestConvertCommandFlags(t *testing.T) {
 	}
 
 	// Check flags exist
-	flags := []string{"output", "llm", "provider", "model", "extract-images", "images-dir", "verbose", "quiet"}
+	flags := []string{"output", "llm", "provider", "model", "extract-images", "images-dir", "extract-attachments", "attachments-dir", "page-markers", "verbose", "quiet"}
 	for _, flag := range flags {
 		if convertCmd.Flags().Lookup(flag) == nil {
 			t.Errorf("expected flag '%s' to exist", flag)
@@ -120,7 +123,7 @@ func TestExtractCommandFlags(t *testing.T) {
 	}
 
 	// Check flags exist
-	flags := []string{"output", "format", "extract-images", "images-dir", "pretty"}
+	flags := []string{"output", "format", "extract-images", "images-dir", "extract-attachments", "attachments-dir", "pretty"}
 	for _, flag := range flags {
 		if extractCmd.Flags().Lookup(flag) == nil {
 			t.Errorf("expected flag '%s' to exist", flag)
@@ -128,6 +131,40 @@ func TestExtractCommandFlags(t *testing.T) {
 	}
 }
 
+func TestFieldsCommandFlags(t *testing.T) {
+	if fieldsCmd.Use != "fields <file>" {
+		t.Errorf("expected Use 'fields <file>', got '%s'", fieldsCmd.Use)
+	}
+
+	for _, flag := range []string{"output", "pretty"} {
+		if fieldsCmd.Flags().Lookup(flag) == nil {
+			t.Errorf("expected flag '%s' to exist", flag)
+		}
+	}
+}
+
+func TestFormFieldMap(t *testing.T) {
+	fields := []*ir.FormField{
+		{Name: "성명", Type: ir.FormFieldClickHere, Value: "홍길동", Block: 0, Page: 1},
+		{Name: "", Type: ir.FormFieldText, Value: "010-0000-0000", Block: 2},
+		{Name: "성명", Type: ir.FormFieldClickHere, Value: "김철수", Block: 3, Cell: &ir.CellRef{Row: 1, Col: 2}},
+	}
+
+	m := formFieldMap(fields)
+	if len(m) != 3 {
+		t.Fatalf("expected 3 entries, got %d: %v", len(m), m)
+	}
+	if e := m["성명"]; e.Value != "홍길동" || e.Type != ir.FormFieldClickHere || e.Location.Page != 1 {
+		t.Errorf("unexpected entry: %+v", e)
+	}
+	if e := m["field2"]; e.Value != "010-0000-0000" || e.Location.Block != 2 {
+		t.Errorf("expected unnamed field to be numbered, got %+v", e)
+	}
+	if e := m["성명#2"]; e.Value != "김철수" || e.Location.Cell == nil || e.Location.Cell.Col != 2 {
+		t.Errorf("expected repeated name to get a suffix, got %+v", e)
+	}
+}
+
 func TestConfigCommand(t *testing.T) {
 	if configCmd.Use != "config" {
 		t.Errorf("expected Use 'config', got '%s'", configCmd.Use)
@@ -238,3 +275,458 @@ func TestDetectProviderFromModel(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatRunsMarkdown(t *testing.T) {
+	tests := []struct {
+		name     string
+		runs     []ir.Run
+		expected string
+	}{
+		{
+			name: "bold with surrounding spaces",
+			runs: []ir.Run{
+				{Text: "제1조 ", Style: ir.TextStyle{Bold: true}},
+				{Text: "목적", Style: ir.TextStyle{}},
+			},
+			expected: "**제1조** 목적",
+		},
+		{
+			name: "underline only is dropped and merged",
+			runs: []ir.Run{
+				{Text: "가", Style: ir.TextStyle{Bold: true}},
+				{Text: "나", Style: ir.TextStyle{Bold: true, Underline: true}},
+			},
+			expected: "**가나**",
+		},
+		{
+			name: "superscript",
+			runs: []ir.Run{
+				{Text: "m", Style: ir.TextStyle{}},
+				{Text: "2", Style: ir.TextStyle{Superscript: true}},
+			},
+			expected: "m<sup>2</sup>",
+		},
+		{
+			name: "link",
+			runs: []ir.Run{
+				{Text: "홈페이지(", Style: ir.TextStyle{}},
+				{Text: "www.example.com", Style: ir.TextStyle{Link: "http://www.example.com"}},
+				{Text: ")", Style: ir.TextStyle{}},
+			},
+			expected: "홈페이지([www.example.com](http://www.example.com))",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result := formatRunsMarkdown(tc.runs)
+			if result != tc.expected {
+				t.Errorf("formatRunsMarkdown() = %q, want %q", result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestConvertToBasicMarkdown_Metadata(t *testing.T) {
+	doc := ir.NewDocument()
+	doc.Metadata = ir.Metadata{
+		Title:          "채용 공고",
+		Author:         "홍길동",
+		Keywords:       "채용: 경력",
+		LastModifiedBy: "mcst",
+		Created:        "2022-11-15T04:45:50Z",
+		PageCount:      3,
+	}
+	doc.AddParagraph(ir.NewParagraph("본문"))
+
+	md := convertToBasicMarkdown(doc, markdownOptions{})
+
+	expected := "---\ntitle: 채용 공고\nauthor: 홍길동\nkeywords: \"채용: 경력\"\nlast_modified_by: mcst\n" +
+		"created: \"2022-11-15T04:45:50Z\"\npages: 3\n---\n\n본문\n\n"
+	if md != expected {
+		t.Errorf("convertToBasicMarkdown() = %q, want %q", md, expected)
+	}
+}
+
+func TestYAMLString(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected string
+	}{
+		{"홍길동", "홍길동"},
+		{"mcst", "mcst"},
+		{"true", `"true"`},
+		{"No", `"No"`},
+		{"null", `"null"`},
+		{"~", `"~"`},
+		{"2024", `"2024"`},
+		{"3.14", `"3.14"`},
+		{"0x1F", `"0x1F"`},
+		{"1_000", `"1_000"`},
+		{".inf", `".inf"`},
+		{"2024-01-02", `"2024-01-02"`},
+		{"12:30", `"12:30"`},
+		{"2024년 보고서", "2024년 보고서"},
+		{"v1.2", "v1.2"},
+	}
+	for _, tt := range tests {
+		if got := yamlString(tt.value); got != tt.expected {
+			t.Errorf("yamlString(%q) = %s, want %s", tt.value, got, tt.expected)
+		}
+	}
+}
+
+func TestConvertToBasicMarkdown_Footnotes(t *testing.T) {
+	doc := ir.NewDocument()
+	p := ir.NewParagraph("본문")
+	p.AddRun("본문", ir.TextStyle{})
+	p.Runs = append(p.Runs, doc.AddFootnote(false, []*ir.Paragraph{ir.NewParagraph("각주 내용")}))
+	doc.AddParagraph(p)
+
+	md := convertToBasicMarkdown(doc, markdownOptions{})
+
+	if !strings.Contains(md, "본문[^1]") {
+		t.Errorf("expected footnote reference, got: %s", md)
+	}
+	if !strings.Contains(md, "[^1]: 각주 내용\n") {
+		t.Errorf("expected footnote definition, got: %s", md)
+	}
+}
+
+func TestConvertToBasicMarkdown_Math(t *testing.T) {
+	doc := ir.NewDocument()
+	p := ir.NewParagraph("넓이는 $\\pi r^{2}$ 이다")
+	p.AddRun("넓이는 ", ir.TextStyle{})
+	p.AddRun(`\pi r^{2}`, ir.TextStyle{Math: true})
+	p.AddRun(" 이다", ir.TextStyle{})
+	doc.AddParagraph(p)
+	doc.AddMath(ir.NewMath(`\frac{a}{b}`, "{a} over {b}"))
+
+	md := convertToBasicMarkdown(doc, markdownOptions{})
+
+	expected := "넓이는 $\\pi r^{2}$ 이다\n\n$$\n\\frac{a}{b}\n$$\n\n"
+	if md != expected {
+		t.Errorf("convertToBasicMarkdown() = %q, want %q", md, expected)
+	}
+}
+
+func TestConvertToBasicMarkdown_TextBox(t *testing.T) {
+	doc := ir.NewDocument()
+	doc.AddParagraph(ir.NewParagraph("본문"))
+	doc.AddTextBox(ir.NewTextBox([]*ir.Paragraph{ir.NewParagraph("핵심 요약"), ir.NewParagraph("둘째 줄")}))
+	doc.AddTextBox(ir.NewTextBox([]*ir.Paragraph{ir.NewParagraph("")}))
+
+	md := convertToBasicMarkdown(doc, markdownOptions{})
+
+	expected := "본문\n\n> 핵심 요약\n>\n> 둘째 줄\n\n"
+	if md != expected {
+		t.Errorf("convertToBasicMarkdown() = %q, want %q", md, expected)
+	}
+}
+
+func TestConvertToBasicMarkdown_Attachment(t *testing.T) {
+	doc := ir.NewDocument()
+	saved := ir.NewAttachment("BIN0003", "xlsx")
+	saved.Path = "attachments/BIN0003.xlsx"
+	doc.AddAttachment(saved)
+	captioned := ir.NewAttachment("BIN0004", "doc")
+	captioned.Caption = "세부 내역"
+	doc.AddAttachment(captioned)
+
+	md := convertToBasicMarkdown(doc, markdownOptions{})
+
+	expected := "[첨부: BIN0003.xlsx](attachments/BIN0003.xlsx)\n\n[첨부: 세부 내역](BIN0004.doc)\n\n"
+	if md != expected {
+		t.Errorf("convertToBasicMarkdown() = %q, want %q", md, expected)
+	}
+}
+
+func TestConvertToBasicMarkdown_Chart(t *testing.T) {
+	doc := ir.NewDocument()
+	table := ir.NewTable(2, 2)
+	table.Cells[0] = []ir.Cell{{Text: "항목"}, {Text: "예산"}}
+	table.Cells[1] = []ir.Cell{{Text: "인건비"}, {Text: "120"}}
+	table.HasHeader = true
+	table.Chart = "BIN0002"
+	table.Caption = "연도별 예산"
+	doc.AddTable(table)
+
+	md := convertToBasicMarkdown(doc, markdownOptions{})
+
+	// 차트 표는 원본 차트 개체를 캡션 줄에 밝힌다
+	if !strings.HasPrefix(md, "*[차트: BIN0002] 연도별 예산*\n\n| 항목 | 예산 |") {
+		t.Errorf("expected chart reference before the table, got %q", md)
+	}
+
+	// 추출한 차트 그림은 값 표 위에 둔다
+	table.ChartImage = &ir.ImageBlock{ID: "BIN0002", Path: "images/BIN0002.bmp"}
+	md = convertToBasicMarkdown(doc, markdownOptions{})
+	if !strings.HasPrefix(md, "*[차트: BIN0002] 연도별 예산*\n\n![BIN0002](images/BIN0002.bmp)\n\n| 항목 | 예산 |") {
+		t.Errorf("expected chart picture before the table, got %q", md)
+	}
+}
+
+func TestConvertToBasicMarkdown_PageMarkers(t *testing.T) {
+	doc := ir.NewDocument()
+	doc.SetPage(1)
+	doc.AddParagraph(ir.NewParagraph("첫 쪽"))
+	doc.AddParagraph(ir.NewParagraph("같은 쪽"))
+	doc.SetPage(12)
+	doc.AddParagraph(ir.NewParagraph("열두째 쪽"))
+
+	md := convertToBasicMarkdown(doc, markdownOptions{PageMarkers: true})
+	expected := "<!-- page 1 -->\n\n첫 쪽\n\n같은 쪽\n\n<!-- page 12 -->\n\n열두째 쪽\n\n"
+	if md != expected {
+		t.Errorf("convertToBasicMarkdown() = %q, want %q", md, expected)
+	}
+
+	// 기본값은 쪽 표시 없음
+	if md := convertToBasicMarkdown(doc, markdownOptions{}); strings.Contains(md, "<!-- page") {
+		t.Errorf("expected no page markers by default, got %q", md)
+	}
+}
+
+func TestConvertToBasicMarkdown_Captions(t *testing.T) {
+	doc := ir.NewDocument()
+	table := ir.NewTable(1, 1)
+	table.Cells[0][0].Text = "셀"
+	table.Caption = "표 1 인구 현황"
+	doc.AddTable(table)
+	img := ir.NewImage("image1")
+	img.Path = "images/map.png"
+	img.Caption = "그림 1\n지도"
+	doc.AddImage(img)
+
+	md := convertToBasicMarkdown(doc, markdownOptions{})
+	expected := "*표 1 인구 현황*\n\n|  |\n| --- |\n| 셀 |\n\n![image1](images/map.png)\n\n*그림 1 지도*\n\n"
+	if md != expected {
+		t.Errorf("convertToBasicMarkdown() = %q, want %q", md, expected)
+	}
+}
+
+func TestConvertToBasicMarkdown_TableFormatting(t *testing.T) {
+	doc := ir.NewDocument()
+	table := ir.NewTable(3, 3)
+	texts := [][]string{{"구분", "수량", "금액"}, {"수입", "1", "100"}, {"지출", "2", "80"}}
+	for i, row := range texts {
+		for j, text := range row {
+			table.Cells[i][j].Text = text
+		}
+		table.Cells[i][1].Style.Alignment = ir.AlignCenter
+		table.Cells[i][2].Style.Alignment = ir.AlignRight
+	}
+	for j := 0; j < 3; j++ {
+		table.Cells[0][j].Style.Background = "#D9D9D9"
+	}
+	table.Cells[1][0].Style.Bold = true
+	table.Cells[2][0].Style.Bold = true
+	table.DetectHeaders()
+	doc.AddTable(table)
+
+	md := convertToBasicMarkdown(doc, markdownOptions{})
+	expected := "| 구분 | 수량 | 금액 |\n| --- | :---: | ---: |\n| **수입** | 1 | 100 |\n| **지출** | 2 | 80 |\n\n"
+	if md != expected {
+		t.Errorf("convertToBasicMarkdown() = %q, want %q", md, expected)
+	}
+}
+
+func TestConvertToBasicMarkdown_NestedTables(t *testing.T) {
+	nested := ir.NewTable(2, 2)
+	nested.Cells[0][0].Text = "항목"
+	nested.Cells[0][0].ColSpan = 2
+	nested.Cells[1][0].Text = "A"
+	nested.Cells[1][1].Text = "B|C"
+	nested.SetHeaderRow()
+
+	doc := ir.NewDocument()
+	table := ir.NewTable(1, 2)
+	table.Cells[0][0].Text = "구분"
+	table.Cells[0][1].Text = "세부 내역\n" + nested.PlainText()
+	table.Cells[0][1].Tables = []*ir.TableBlock{nested}
+	doc.AddTable(table)
+
+	tests := []struct {
+		mode     string
+		expected string
+	}{
+		{nestedTablesFlatten, "|  |  |\n| --- | --- |\n| 구분 | 세부 내역 항목 A \\| B\\|C |\n\n"},
+		{nestedTablesHTML, "|  |  |\n| --- | --- |\n| 구분 | 세부 내역 " +
+			`<table><tr><th colspan="2">항목</th></tr><tr><td>A</td><td>B&#124;C</td></tr></table> |` + "\n\n"},
+		{nestedTablesAfter, "|  |  |\n| --- | --- |\n| 구분 | 세부 내역 [내부 표 1] |\n\n" +
+			"*[내부 표 1]*\n\n| 항목 |  |\n| --- | --- |\n| A | B\\|C |\n\n"},
+	}
+	for _, tc := range tests {
+		md := convertToBasicMarkdown(doc, markdownOptions{NestedTables: tc.mode})
+		if md != tc.expected {
+			t.Errorf("%s: convertToBasicMarkdown() = %q, want %q", tc.mode, md, tc.expected)
+		}
+	}
+
+	// 내부 표 번호는 문서 전체에서 이어진다
+	doc.AddTable(table)
+	md := convertToBasicMarkdown(doc, markdownOptions{NestedTables: nestedTablesAfter})
+	if strings.Count(md, "[내부 표 1]") != 2 || strings.Count(md, "[내부 표 2]") != 2 {
+		t.Errorf("expected nested tables numbered across the document, got %q", md)
+	}
+}
+
+func TestConvertToBasicMarkdown_Images(t *testing.T) {
+	icon := &ir.ImageBlock{ID: "image1", Path: "BinData/image1.png", Alt: "아이콘"}
+	sign := &ir.ImageBlock{ID: "image2", Path: "BinData/image2.png"}
+
+	doc := ir.NewDocument()
+	p := ir.NewParagraph("")
+	p.AddRun("표시 ", ir.TextStyle{})
+	p.Runs = append(p.Runs, ir.Run{Image: icon})
+	p.AddRun(" 참고", ir.TextStyle{})
+	p.Text = ir.RunsText(p.Runs)
+	doc.AddParagraph(p)
+
+	nested := ir.NewTable(1, 2)
+	nested.Cells[0][0].Text = "서명"
+	nested.Cells[0][1].Text = "(인) " + sign.Markdown()
+	nested.Cells[0][1].Images = []*ir.ImageBlock{sign}
+	table := ir.NewTable(1, 1)
+	table.Cells[0][0].Text = nested.PlainText()
+	table.Cells[0][0].Tables = []*ir.TableBlock{nested}
+	doc.AddTable(table)
+
+	md := convertToBasicMarkdown(doc, markdownOptions{NestedTables: nestedTablesHTML})
+	expected := "표시 ![아이콘](BinData/image1.png) 참고\n\n" +
+		"|  |\n| --- |\n| " +
+		`<table><tr><td>서명</td><td>(인) <img src="BinData/image2.png" alt="image2"></td></tr></table> |` + "\n\n"
+	if md != expected {
+		t.Errorf("convertToBasicMarkdown() = %q, want %q", md, expected)
+	}
+
+	// Flattened cells keep the Markdown image
+	md = convertToBasicMarkdown(doc, markdownOptions{NestedTables: nestedTablesFlatten})
+	if !strings.Contains(md, "| 서명 \\| (인) ![image2](BinData/image2.png) |") {
+		t.Errorf("expected the image in the flattened cell, got %q", md)
+	}
+}
+
+func TestConvertToBasicMarkdown_Annotations(t *testing.T) {
+	doc := ir.NewDocument()
+	p := ir.NewParagraph("")
+	p.AddRun("기한은 ", ir.TextStyle{})
+	p.AddRun("30일", ir.TextStyle{Change: ir.ChangeDelete})
+	p.AddRun("60일", ir.TextStyle{Change: ir.ChangeInsert, Bold: true})
+	p.AddRun("로 ", ir.TextStyle{})
+	ref := doc.AddComment("검토자", "", []*ir.Paragraph{ir.NewParagraph("근거 확인")})
+	p.AddRun("한다", ir.TextStyle{Comment: ref.Style.CommentRef})
+	p.Runs = append(p.Runs, ref)
+	p.Text = ir.RunsText(p.Runs)
+	doc.AddParagraph(p)
+
+	md := convertToBasicMarkdown(doc, markdownOptions{})
+	expected := "기한은 {--30일--}{++**60일**++}로 {==한다==}{>>검토자: 근거 확인<<}\n\n"
+	if md != expected {
+		t.Errorf("convertToBasicMarkdown() = %q, want %q", md, expected)
+	}
+
+	doc.ResolveChanges(true)
+	md = convertToBasicMarkdown(doc, markdownOptions{})
+	if expected := "기한은 **60일**로 한다\n\n"; md != expected {
+		t.Errorf("after accepting changes = %q, want %q", md, expected)
+	}
+}
+
+func TestConvertToBasicMarkdown_Anchors(t *testing.T) {
+	doc := ir.NewDocument()
+	ref := ir.NewParagraph("별표 3 참조")
+	ref.AddRun("별표 3", ir.TextStyle{Link: "#별표-3"})
+	ref.AddRun(" 참조", ir.TextStyle{})
+	doc.AddParagraph(ref)
+	doc.AddAnchor("별표 3")
+	doc.AddParagraph(ir.NewParagraph("[별표 3] 서식"))
+
+	md := convertToBasicMarkdown(doc, markdownOptions{})
+	expected := "[별표 3](#별표-3) 참조\n\n<a id=\"별표-3\"></a>\n\n[별표 3] 서식\n\n"
+	if md != expected {
+		t.Errorf("convertToBasicMarkdown() = %q, want %q", md, expected)
+	}
+}
+
+func TestConvertToBasicMarkdown_HeaderFooter(t *testing.T) {
+	doc := ir.NewDocument()
+	doc.AddHeader(ir.PageScopeBoth, []*ir.Paragraph{ir.NewParagraph("머리말")})
+	doc.AddFooter(ir.PageScopeBoth, []*ir.Paragraph{ir.NewParagraph("꼬리말")})
+	doc.AddParagraph(ir.NewParagraph("본문"))
+
+	tests := []struct {
+		mode     string
+		expected string
+	}{
+		{headerFooterDrop, "본문\n\n"},
+		{headerFooterFrontMatter, "---\nheaders:\n  - scope: both\n    text: \"머리말\"\nfooters:\n  - scope: both\n    text: \"꼬리말\"\n---\n\n본문\n\n"},
+		{headerFooterOnce, "머리말\n\n---\n\n본문\n\n---\n\n꼬리말\n\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			md := convertToBasicMarkdown(doc, markdownOptions{HeaderFooter: tt.mode})
+			if md != tt.expected {
+				t.Errorf("convertToBasicMarkdown() = %q, want %q", md, tt.expected)
+			}
+		})
+	}
+}
+
+func TestConvertToBasicMarkdown_PictureHeader(t *testing.T) {
+	logo := &ir.Paragraph{Runs: []ir.Run{{Image: &ir.ImageBlock{ID: "logo", Path: "images/logo.png"}}}}
+	doc := ir.NewDocument()
+	doc.AddHeader(ir.PageScopeBoth, []*ir.Paragraph{logo})
+	doc.AddParagraph(ir.NewParagraph("본문"))
+
+	tests := []struct {
+		mode     string
+		expected string
+	}{
+		{headerFooterFrontMatter, "---\nheaders:\n  - scope: both\n    text: \"![logo](images/logo.png)\"\n---\n\n본문\n\n"},
+		{headerFooterOnce, "![logo](images/logo.png)\n\n---\n\n본문\n\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			md := convertToBasicMarkdown(doc, markdownOptions{HeaderFooter: tt.mode})
+			if md != tt.expected {
+				t.Errorf("convertToBasicMarkdown() = %q, want %q", md, tt.expected)
+			}
+		})
+	}
+}
+
+func TestWriteMarkdownList_Nested(t *testing.T) {
+	sub := ir.NewUnorderedList()
+	sub.Level = 1
+	sub.AddItem("하위 항목")
+
+	list := ir.NewOrderedList()
+	list.Start = 3
+	list.AddItem("첫 항목")
+	list.Items[0].Sublist = sub
+	list.AddItem("둘째 항목")
+
+	var sb strings.Builder
+	writeMarkdownList(&sb, list)
+
+	expected := "3. 첫 항목\n   - 하위 항목\n4. 둘째 항목\n\n"
+	if sb.String() != expected {
+		t.Errorf("writeMarkdownList() = %q, want %q", sb.String(), expected)
+	}
+
+	// A following sublist of another kind is written under the same item
+	next := ir.NewOrderedList()
+	next.Level = 1
+	next.AddItem("번호 항목")
+	sub.Next = next
+
+	sb.Reset()
+	writeMarkdownList(&sb, list)
+
+	expected = "3. 첫 항목\n   - 하위 항목\n   1. 번호 항목\n4. 둘째 항목\n\n"
+	if sb.String() != expected {
+		t.Errorf("writeMarkdownList() = %q, want %q", sb.String(), expected)
+	}
+}
@@ -3,9 +3,13 @@ package cli
 import (
 	"context"
 	"fmt"
+	"html"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
+	"unicode"
 
 	"github.com/roboco-io/hwp2md/internal/config"
 	"github.com/roboco-io/hwp2md/internal/ir"
@@ -31,10 +35,44 @@ var (
 	convertParser      string
 	convertExtractImgs bool
 	convertImagesDir   string
+	convertExtractAtts bool
+	convertAttsDir     string
 	convertVerbose     bool
 	convertQuiet       bool
+	convertHeaderMode  string
+	convertChanges     string
+	convertPageMarkers bool
+	convertNested      string
 )
 
+// 머리말/꼬리말 출력 방식 (--header-footer)
+const (
+	headerFooterDrop        = "drop"         // 출력하지 않음
+	headerFooterFrontMatter = "front-matter" // YAML front matter에 포함
+	headerFooterOnce        = "once"         // 본문 앞뒤에 한 번만 출력
+)
+
+// 변경 내용 추적과 메모 처리 방식 (--changes)
+const (
+	changesMarkup = "markup" // CriticMarkup으로 표시
+	changesAccept = "accept" // 모든 변경 적용, 메모 제거
+	changesReject = "reject" // 모든 변경 취소, 메모 제거
+)
+
+// 표 안에 들어 있는 표의 출력 방식 (--nested-tables)
+const (
+	nestedTablesFlatten = "flatten" // 셀 안에 "a | b" 형식의 텍스트로 펼침
+	nestedTablesHTML    = "html"    // 셀 안에 HTML <table>로 출력
+	nestedTablesAfter   = "after"   // 셀에는 [내부 표 N] 표시만 남기고 바깥 표 뒤에 따로 출력
+)
+
+// markdownOptions controls basic (Stage 1) Markdown rendering.
+type markdownOptions struct {
+	HeaderFooter string // headerFooterDrop, headerFooterFrontMatter, headerFooterOnce
+	PageMarkers  bool   // 블록의 (추정) 시작 쪽이 바뀔 때 <!-- page N --> 주석 출력
+	NestedTables string // nestedTablesFlatten (기본), nestedTablesHTML, nestedTablesAfter
+}
+
 var convertCmd = &cobra.Command{
 	Use:   "convert <file>",
 	Short: "HWP/HWPX 문서를 Markdown으로 변환",
@@ -74,7 +112,10 @@ var convertCmd = &cobra.Command{
   hwp2md convert document.hwpx --llm --model gpt-4o
   hwp2md convert document.hwpx --llm --model solar-pro
   hwp2md convert document.hwpx --llm --base-url http://localhost:8080
-  hwp2md convert document.hwpx --extract-images ./images`,
+  hwp2md convert document.hwpx --extract-images ./images
+  hwp2md convert document.hwp --extract-attachments --attachments-dir ./attachments
+  hwp2md convert document.hwpx --header-footer front-matter
+  hwp2md convert document.hwpx --changes accept`,
 	Args: cobra.ExactArgs(1),
 	RunE: runConvert,
 }
@@ -88,8 +129,14 @@ func init() {
 	convertCmd.Flags().StringVar(&convertParser, "parser", "", "파서 선택 (native, upstage)")
 	convertCmd.Flags().BoolVar(&convertExtractImgs, "extract-images", false, "이미지 추출 활성화")
 	convertCmd.Flags().StringVar(&convertImagesDir, "images-dir", "./images", "추출된 이미지 저장 디렉토리")
+	convertCmd.Flags().BoolVar(&convertExtractAtts, "extract-attachments", false, "삽입된 OLE 개체(스프레드시트 등) 추출 활성화")
+	convertCmd.Flags().StringVar(&convertAttsDir, "attachments-dir", "./attachments", "추출된 첨부 파일 저장 디렉토리")
 	convertCmd.Flags().BoolVarP(&convertVerbose, "verbose", "v", false, "상세 출력")
 	convertCmd.Flags().BoolVarP(&convertQuiet, "quiet", "q", false, "조용한 모드")
+	convertCmd.Flags().StringVar(&convertHeaderMode, "header-footer", headerFooterDrop, "머리말/꼬리말 출력 방식 (drop, front-matter, once)")
+	convertCmd.Flags().StringVar(&convertChanges, "changes", changesMarkup, "변경 내용과 메모 처리 방식 (markup, accept, reject)")
+	convertCmd.Flags().BoolVar(&convertPageMarkers, "page-markers", false, "쪽이 바뀌는 위치에 <!-- page N --> 주석 출력 (줄 배치로 추정)")
+	convertCmd.Flags().StringVar(&convertNested, "nested-tables", nestedTablesFlatten, "표 안의 표 출력 방식 (flatten, html, after)")
 
 	rootCmd.AddCommand(convertCmd)
 }
@@ -108,6 +155,24 @@ func runConvert(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("지원하지 않는 파일 형식입니다: %s", filepath.Ext(inputPath))
 	}
 
+	switch convertHeaderMode {
+	case headerFooterDrop, headerFooterFrontMatter, headerFooterOnce:
+	default:
+		return fmt.Errorf("지원하지 않는 머리말/꼬리말 출력 방식입니다: %s (지원: drop, front-matter, once)", convertHeaderMode)
+	}
+
+	switch convertChanges {
+	case changesMarkup, changesAccept, changesReject:
+	default:
+		return fmt.Errorf("지원하지 않는 변경 내용 처리 방식입니다: %s (지원: markup, accept, reject)", convertChanges)
+	}
+
+	switch convertNested {
+	case nestedTablesFlatten, nestedTablesHTML, nestedTablesAfter:
+	default:
+		return fmt.Errorf("지원하지 않는 중첩 표 출력 방식입니다: %s (지원: flatten, html, after)", convertNested)
+	}
+
 	if !convertQuiet && convertVerbose {
 		fmt.Fprintf(cmd.ErrOrStderr(), "입력 파일: %s\n", inputPath)
 		fmt.Fprintf(cmd.ErrOrStderr(), "파일 형식: %s\n", format)
@@ -136,6 +201,11 @@ func runConvert(cmd *cobra.Command, args []string) error {
 		fmt.Fprintf(cmd.ErrOrStderr(), "파싱 완료: %d 블록\n", len(doc.Content))
 	}
 
+	// 변경 내용 적용/취소 (markup이면 CriticMarkup으로 남긴다)
+	if convertChanges != changesMarkup {
+		doc.ResolveChanges(convertChanges == changesAccept)
+	}
+
 	// Check if LLM should be used
 	useLLM := convertUseLLM || config.GetEnvBool("HWP2MD_LLM")
 
@@ -158,7 +228,11 @@ func runConvert(cmd *cobra.Command, args []string) error {
 		}
 	} else {
 		// Stage 1 only: Basic markdown conversion
-		markdown = convertToBasicMarkdown(doc)
+		markdown = convertToBasicMarkdown(doc, markdownOptions{
+			HeaderFooter: convertHeaderMode,
+			PageMarkers:  convertPageMarkers,
+			NestedTables: convertNested,
+		})
 	}
 
 	// Write output
@@ -194,8 +268,10 @@ func parseDocumentForConvert(cmd *cobra.Command, path string, format parser.Form
 
 	// Native parser
 	opts := parser.Options{
-		ExtractImages: convertExtractImgs,
-		ImageDir:      convertImagesDir,
+		ExtractImages:      convertExtractImgs,
+		ImageDir:           convertImagesDir,
+		ExtractAttachments: convertExtractAtts,
+		AttachmentDir:      convertAttsDir,
 	}
 
 	switch format {
@@ -205,6 +281,11 @@ func parseDocumentForConvert(cmd *cobra.Command, path string, format parser.Form
 			return nil, err
 		}
 		defer p.Close()
+		if !convertQuiet {
+			for _, warning := range p.Warnings() {
+				fmt.Fprintf(cmd.ErrOrStderr(), "경고: %v\n", warning)
+			}
+		}
 		return p.Parse()
 
 	case parser.FormatHWP:
@@ -313,19 +394,14 @@ func formatWithLLM(cmd *cobra.Command, doc *ir.Document) (string, *llm.FormatRes
 	return result.Markdown, result, nil
 }
 
-func convertToBasicMarkdown(doc *ir.Document) string {
+func convertToBasicMarkdown(doc *ir.Document, opts markdownOptions) string {
 	// If RawMarkdown is available (e.g., from Upstage parser), use it directly
 	if doc.RawMarkdown != "" {
 		var sb strings.Builder
 		// Add front matter if metadata exists
-		if doc.Metadata.Title != "" || doc.Metadata.Author != "" {
+		if hasMetadata(doc.Metadata) {
 			sb.WriteString("---\n")
-			if doc.Metadata.Title != "" {
-				sb.WriteString(fmt.Sprintf("title: %s\n", doc.Metadata.Title))
-			}
-			if doc.Metadata.Author != "" {
-				sb.WriteString(fmt.Sprintf("author: %s\n", doc.Metadata.Author))
-			}
+			writeMetadataFrontMatter(&sb, doc.Metadata)
 			sb.WriteString("---\n\n")
 		}
 		sb.WriteString(doc.RawMarkdown)
@@ -335,19 +411,35 @@ func convertToBasicMarkdown(doc *ir.Document) string {
 	var sb strings.Builder
 
 	// Metadata as YAML front matter (optional)
-	if doc.Metadata.Title != "" || doc.Metadata.Author != "" {
+	withHeaders := opts.HeaderFooter == headerFooterFrontMatter &&
+		(len(doc.Headers) > 0 || len(doc.Footers) > 0 || len(doc.MasterPages) > 0)
+	if hasMetadata(doc.Metadata) || withHeaders {
 		sb.WriteString("---\n")
-		if doc.Metadata.Title != "" {
-			sb.WriteString(fmt.Sprintf("title: %s\n", doc.Metadata.Title))
-		}
-		if doc.Metadata.Author != "" {
-			sb.WriteString(fmt.Sprintf("author: %s\n", doc.Metadata.Author))
+		writeMetadataFrontMatter(&sb, doc.Metadata)
+		if withHeaders {
+			writeHeaderFooterFrontMatter(&sb, "headers", doc.Headers)
+			writeHeaderFooterFrontMatter(&sb, "footers", doc.Footers)
+			writeHeaderFooterFrontMatter(&sb, "master_pages", doc.MasterPages)
 		}
 		sb.WriteString("---\n\n")
 	}
 
-	// Content
+	// Headers (and master page text) rendered once before the body
+	if opts.HeaderFooter == headerFooterOnce && (len(doc.Headers) > 0 || len(doc.MasterPages) > 0) {
+		writeHeaderFooterOnce(&sb, doc.Headers, doc.MasterPages)
+		sb.WriteString("---\n\n")
+	}
+
+	// Content (bookmarks become HTML anchors before their block)
+	page := 0
+	afterTables := 0 // 바깥 표 뒤에 출력한 내부 표 수 (문서 전체에서 번호를 이어 붙인다)
 	for _, block := range doc.Content {
+		if opts.PageMarkers && block.Page > 0 && block.Page != page {
+			page = block.Page
+			fmt.Fprintf(&sb, "<!-- page %d -->\n\n", page)
+		}
+		writeMarkdownAnchors(&sb, block.Anchors)
+
 		switch block.Type {
 		case ir.BlockTypeParagraph:
 			if block.Paragraph != nil {
@@ -355,7 +447,7 @@ func convertToBasicMarkdown(doc *ir.Document) string {
 			}
 		case ir.BlockTypeTable:
 			if block.Table != nil {
-				writeMarkdownTable(&sb, block.Table)
+				writeMarkdownTable(&sb, block.Table, opts.NestedTables, &afterTables)
 			}
 		case ir.BlockTypeImage:
 			if block.Image != nil {
@@ -365,16 +457,140 @@ func convertToBasicMarkdown(doc *ir.Document) string {
 			if block.List != nil {
 				writeMarkdownList(&sb, block.List)
 			}
+		case ir.BlockTypeMath:
+			if block.Math != nil {
+				writeMarkdownMath(&sb, block.Math)
+			}
+		case ir.BlockTypeTextBox:
+			if block.TextBox != nil {
+				writeMarkdownTextBox(&sb, block.TextBox)
+			}
+		case ir.BlockTypeAttachment:
+			if block.Attachment != nil {
+				writeMarkdownAttachment(&sb, block.Attachment)
+			}
 		}
 	}
 
+	// Footers rendered once after the body
+	if opts.HeaderFooter == headerFooterOnce && len(doc.Footers) > 0 {
+		sb.WriteString("---\n\n")
+		writeHeaderFooterOnce(&sb, doc.Footers)
+	}
+
+	writeMarkdownFootnotes(&sb, doc.Footnotes)
+
 	return sb.String()
 }
 
+// hasMetadata reports whether any metadata field is written to the front matter.
+func hasMetadata(meta ir.Metadata) bool {
+	return meta.Title != "" || meta.Author != "" || meta.Subject != "" || meta.Keywords != "" ||
+		meta.Description != "" || meta.LastModifiedBy != "" || meta.Created != "" || meta.Modified != "" ||
+		meta.PageCount > 0
+}
+
+// writeMetadataFrontMatter writes the document metadata fields as YAML key/value pairs.
+func writeMetadataFrontMatter(sb *strings.Builder, meta ir.Metadata) {
+	fields := []struct {
+		key   string
+		value string
+	}{
+		{"title", meta.Title},
+		{"author", meta.Author},
+		{"subject", meta.Subject},
+		{"keywords", meta.Keywords},
+		{"description", meta.Description},
+		{"last_modified_by", meta.LastModifiedBy},
+		{"created", meta.Created},
+		{"modified", meta.Modified},
+	}
+	for _, f := range fields {
+		if f.value != "" {
+			sb.WriteString(fmt.Sprintf("%s: %s\n", f.key, yamlString(f.value)))
+		}
+	}
+	if meta.PageCount > 0 {
+		sb.WriteString(fmt.Sprintf("pages: %d\n", meta.PageCount))
+	}
+}
+
+// yamlString returns s as a YAML scalar, quoting it only when a plain scalar would be misread.
+func yamlString(s string) string {
+	if s == "" || s != strings.TrimSpace(s) || strings.ContainsAny(s, "\n\t\"\\") ||
+		strings.Contains(s, ": ") || strings.Contains(s, " #") || strings.HasSuffix(s, ":") ||
+		strings.ContainsRune("-?:,[]{}#&*!|>'%@`", rune(s[0])) || yamlNonString(s) {
+		return strconv.Quote(s)
+	}
+	return s
+}
+
+// yamlTimestamp matches plain scalars that YAML loaders read as dates, times or
+// sexagesimal numbers (e.g. 2024-01-02, 2022-11-15T04:45:50Z, 12:30).
+var yamlTimestamp = regexp.MustCompile(`^(\d{4}-\d{1,2}-\d{1,2}|[-+]?\d+(:[0-5]?\d)+(\.\d*)?$)`)
+
+// yamlNonString reports whether a plain scalar would load as a boolean, null, number or
+// timestamp instead of a string (YAML 1.1 and 1.2 rules).
+func yamlNonString(s string) bool {
+	switch strings.ToLower(s) {
+	case "true", "false", "yes", "no", "on", "off", "y", "n", "null", "~",
+		".inf", "+.inf", "-.inf", ".nan":
+		return true
+	}
+	// 정수(0x, 0o, 0b 포함)와 실수, 자릿수 구분 '_'가 든 수
+	plain := strings.ReplaceAll(s, "_", "")
+	if _, err := strconv.ParseInt(plain, 0, 64); err == nil {
+		return true
+	}
+	if _, err := strconv.ParseFloat(plain, 64); err == nil {
+		return true
+	}
+	return yamlTimestamp.MatchString(s)
+}
+
+// writeHeaderFooterFrontMatter writes header/footer text as a YAML list of scope/text pairs.
+func writeHeaderFooterFrontMatter(sb *strings.Builder, key string, list []*ir.HeaderFooter) {
+	if len(list) == 0 {
+		return
+	}
+	sb.WriteString(key + ":\n")
+	for _, hf := range list {
+		sb.WriteString(fmt.Sprintf("  - scope: %s\n", hf.Scope))
+		sb.WriteString(fmt.Sprintf("    text: %s\n", strconv.Quote(hf.Text())))
+	}
+}
+
+// writeHeaderFooterOnce writes header/footer text as plain paragraphs.
+// 구역마다 반복되는 머리말/꼬리말은 IR에서 이미 중복 제거되어 있다.
+func writeHeaderFooterOnce(sb *strings.Builder, lists ...[]*ir.HeaderFooter) {
+	for _, list := range lists {
+		for _, hf := range list {
+			for _, p := range hf.Paragraphs {
+				writeMarkdownParagraph(sb, p)
+			}
+		}
+	}
+}
+
+// writeMarkdownAnchors writes bookmark anchors (<a id="..."></a>) that cross-reference links (#id) point to.
+func writeMarkdownAnchors(sb *strings.Builder, anchors []string) {
+	if len(anchors) == 0 {
+		return
+	}
+	for _, id := range anchors {
+		sb.WriteString(`<a id="` + id + `"></a>`)
+	}
+	sb.WriteString("\n\n")
+}
+
 func writeMarkdownParagraph(sb *strings.Builder, p *ir.Paragraph) {
 	text := strings.TrimSpace(p.Text)
 	if text == "" {
-		return
+		// 그림만 있는 문단(머리말 로고 등)은 그림을 쓴다
+		text = strings.TrimSpace(formatRunsMarkdown(p.Runs))
+		if text == "" {
+			return
+		}
 	}
 
 	// Handle headings
@@ -384,13 +600,167 @@ func writeMarkdownParagraph(sb *strings.Builder, p *ir.Paragraph) {
 		return
 	}
 
+	if p.HasStyledRuns() {
+		if styled := strings.TrimSpace(formatRunsMarkdown(p.Runs)); styled != "" {
+			text = styled
+		}
+	}
+
 	sb.WriteString(text + "\n\n")
 }
 
-func writeMarkdownTable(sb *strings.Builder, t *ir.TableBlock) {
+// formatRunsMarkdown renders paragraph runs with Markdown emphasis.
+// 밑줄과 음영은 Markdown 표기가 없으므로 텍스트만 남기고, 표기가 같아진 인접 구간은 합친다.
+func formatRunsMarkdown(runs []ir.Run) string {
+	var merged []ir.Run
+	for _, run := range runs {
+		if run.Image != nil {
+			merged = append(merged, run)
+			continue
+		}
+		style := ir.TextStyle{
+			Bold:          run.Style.Bold,
+			Italic:        run.Style.Italic,
+			Strikethrough: run.Style.Strikethrough,
+			Superscript:   run.Style.Superscript,
+			Subscript:     run.Style.Subscript,
+			Code:          run.Style.Code,
+			Link:          run.Style.Link,
+			FootnoteRef:   run.Style.FootnoteRef,
+			Math:          run.Style.Math,
+			Change:        run.Style.Change,
+			Comment:       run.Style.Comment,
+			CommentRef:    run.Style.CommentRef,
+		}
+		if n := len(merged); n > 0 && merged[n-1].Image == nil && merged[n-1].Style == style {
+			merged[n-1].Text += run.Text
+			continue
+		}
+		merged = append(merged, ir.Run{Text: run.Text, Style: style})
+	}
+
+	// 메모를 붙인 구간은 {==...==}로 묶는다
+	var sb strings.Builder
+	comment := ""
+	for _, run := range merged {
+		if run.Style.Comment != comment {
+			if comment != "" {
+				sb.WriteString("==}")
+			}
+			if run.Style.Comment != "" {
+				sb.WriteString("{==")
+			}
+			comment = run.Style.Comment
+		}
+		sb.WriteString(formatRunMarkdown(run))
+	}
+	if comment != "" {
+		sb.WriteString("==}")
+	}
+	return sb.String()
+}
+
+// formatRunMarkdown wraps a single run in Markdown emphasis markers.
+// 강조 표시 안쪽에 공백이 있으면 Markdown에서 인식되지 않으므로 공백은 바깥에 둔다.
+// 변경 내용은 강조 표시 바깥을 CriticMarkup({++...++}, {--...--})으로 감싸고, 메모는 {>>...<<}가 된다.
+func formatRunMarkdown(run ir.Run) string {
+	if run.Image != nil {
+		return run.Image.Markdown()
+	}
+	if run.Style.CommentRef != "" {
+		return "{>>" + run.Text + "<<}"
+	}
+	if run.Style.Change != "" {
+		style := run.Style
+		style.Change = ""
+		return ir.CriticChange(run.Style.Change, formatRunMarkdown(ir.Run{Text: run.Text, Style: style}))
+	}
+	if run.Style.FootnoteRef != "" {
+		return "[^" + run.Style.FootnoteRef + "]"
+	}
+	if run.Style.Math {
+		return "$" + strings.TrimSpace(run.Text) + "$"
+	}
+
+	core := strings.TrimSpace(run.Text)
+	if core == "" {
+		return run.Text
+	}
+	lead := run.Text[:len(run.Text)-len(strings.TrimLeftFunc(run.Text, unicode.IsSpace))]
+	trail := run.Text[len(strings.TrimRightFunc(run.Text, unicode.IsSpace)):]
+
+	text := core
+	switch {
+	case run.Style.Code:
+		text = "`" + text + "`"
+	case run.Style.Superscript:
+		text = "<sup>" + text + "</sup>"
+	case run.Style.Subscript:
+		text = "<sub>" + text + "</sub>"
+	}
+	if run.Style.Strikethrough {
+		text = "~~" + text + "~~"
+	}
+	if run.Style.Italic {
+		text = "*" + text + "*"
+	}
+	if run.Style.Bold {
+		text = "**" + text + "**"
+	}
+	if run.Style.Link != "" {
+		text = "[" + text + "](" + run.Style.Link + ")"
+	}
+
+	return lead + text + trail
+}
+
+// writeMarkdownFootnotes writes GFM footnote definitions ([^id]: text).
+// 여러 문단으로 된 각주는 이어지는 문단을 4칸 들여써서 같은 각주로 묶는다.
+func writeMarkdownFootnotes(sb *strings.Builder, footnotes []*ir.Footnote) {
+	for _, fn := range footnotes {
+		var paragraphs []string
+		for _, p := range fn.Paragraphs {
+			text := strings.TrimSpace(p.Text)
+			if p.HasStyledRuns() {
+				text = strings.TrimSpace(formatRunsMarkdown(p.Runs))
+			}
+			if text != "" {
+				paragraphs = append(paragraphs, strings.ReplaceAll(text, "\n", "\n    "))
+			}
+		}
+
+		sb.WriteString(fmt.Sprintf("[^%s]: %s\n", fn.ID, strings.Join(paragraphs, "\n\n    ")))
+	}
+	if len(footnotes) > 0 {
+		sb.WriteString("\n")
+	}
+}
+
+// writeMarkdownTable writes a table as a GFM table.
+// nested selects how tables inside cells are written (nestedTablesFlatten, nestedTablesHTML, nestedTablesAfter).
+// afterCount is the number of nested tables already written after their tables in the document;
+// the labels of the nested tables of this table continue from it.
+func writeMarkdownTable(sb *strings.Builder, t *ir.TableBlock, nested string, afterCount *int) {
+	writeLabeledMarkdownTable(sb, t, nested, "", afterCount)
+}
+
+// writeLabeledMarkdownTable writes a table whose nested tables are labelled under label
+// (e.g. "1", "1-2") when they are written after it.
+// Pipes in cell text are escaped so they do not split the cell.
+func writeLabeledMarkdownTable(sb *strings.Builder, t *ir.TableBlock, nested, label string, afterCount *int) {
 	if len(t.Cells) == 0 {
 		return
 	}
+	caption := t.Caption
+	if t.Chart != "" {
+		// 차트에서 읽은 표는 원본 차트 개체를 함께 밝힌다
+		caption = strings.TrimSpace("[차트: " + t.Chart + "] " + caption)
+	}
+	writeMarkdownCaption(sb, caption)
+	if t.ChartImage != nil {
+		// 차트 그림은 캡션 아래, 값 표 위에 둔다
+		sb.WriteString(t.ChartImage.Markdown() + "\n\n")
+	}
 
 	// Check if this is an "info-box" style table that should be converted to list format
 	if isInfoBoxTable(t) {
@@ -437,6 +807,38 @@ func writeMarkdownTable(sb *strings.Builder, t *ir.TableBlock) {
 		}
 	}
 
+	// GFM tables always start with a header row; without a detected header it is left blank
+	alignments := t.ColumnAlignments()
+	if !t.HasHeader {
+		sb.WriteString("|" + strings.Repeat("  |", numCols) + "\n")
+		writeMarkdownTableSeparator(sb, alignments)
+	}
+
+	// 셀 안의 표는 방식에 따라 셀 텍스트의 펼친 텍스트를 HTML 또는 참조 표시로 바꾼다
+	var after []*ir.TableBlock
+	var afterLabels []string
+	escape := func(s string) string { return strings.ReplaceAll(s, "|", "\\|") }
+	cellText := func(cell ir.Cell) string {
+		switch nested {
+		case nestedTablesHTML:
+			return renderNestedTables(cell, escape, tableHTML)
+		case nestedTablesAfter:
+			return renderNestedTables(cell, escape, func(child *ir.TableBlock) string {
+				var childLabel string
+				if label == "" {
+					*afterCount++
+					childLabel = strconv.Itoa(*afterCount)
+				} else {
+					childLabel = label + "-" + strconv.Itoa(len(after)+1)
+				}
+				after = append(after, child)
+				afterLabels = append(afterLabels, childLabel)
+				return "[내부 표 " + childLabel + "]"
+			})
+		}
+		return escape(cell.Text)
+	}
+
 	// Write rows
 	for i := range t.Cells {
 		sb.WriteString("|")
@@ -445,8 +847,12 @@ func writeMarkdownTable(sb *strings.Builder, t *ir.TableBlock) {
 			var text string
 			if ref.row >= 0 && ref.col >= 0 {
 				if ref.row == i && ref.col == j {
-					// This is the original cell
-					text = strings.ReplaceAll(t.Cells[i][j].Text, "\n", " ")
+					// This is the original cell (row headings of a header column in bold)
+					cell := t.Cells[i][j]
+					text = strings.ReplaceAll(cellText(cell), "\n", " ")
+					if t.HeaderColumn && cell.Style.IsHeader && j == 0 && (i > 0 || !t.HasHeader) && strings.TrimSpace(text) != "" {
+						text = "**" + strings.TrimSpace(text) + "**"
+					}
 				} else if ref.row < i && ref.col == j {
 					// Vertically merged cell (rowspan) - use 〃
 					text = "〃"
@@ -460,12 +866,131 @@ func writeMarkdownTable(sb *strings.Builder, t *ir.TableBlock) {
 		sb.WriteString("\n")
 
 		// Write separator after header row
-		if i == 0 {
-			sb.WriteString("|")
-			for j := 0; j < numCols; j++ {
-				sb.WriteString(" --- |")
+		if i == 0 && t.HasHeader {
+			writeMarkdownTableSeparator(sb, alignments)
+		}
+	}
+	sb.WriteString("\n")
+
+	// 참조 표시를 남긴 내부 표는 바깥 표 바로 뒤에 캡션과 함께 출력
+	for k, child := range after {
+		labeled := *child
+		labeled.Caption = strings.TrimSpace("[내부 표 " + afterLabels[k] + "] " + child.Caption)
+		writeLabeledMarkdownTable(sb, &labeled, nested, afterLabels[k], afterCount)
+	}
+}
+
+// renderNestedTables returns the text of a cell with the flattened text of each nested table
+// replaced by render(table). The rest of the text is passed through escape.
+// 펼친 텍스트를 찾지 못한 표는 셀 텍스트 끝에 붙인다.
+func renderNestedTables(cell ir.Cell, escape func(string) string, render func(*ir.TableBlock) string) string {
+	text := cell.Text
+	var sb strings.Builder
+	var missing []string
+	for _, child := range cell.Tables {
+		flat := child.PlainText()
+		idx := strings.Index(text, flat)
+		if flat == "" || idx < 0 {
+			missing = append(missing, render(child))
+			continue
+		}
+		sb.WriteString(escape(text[:idx]))
+		sb.WriteString(render(child))
+		text = text[idx+len(flat):]
+	}
+	sb.WriteString(escape(text))
+	for _, rendered := range missing {
+		if sb.Len() > 0 {
+			sb.WriteString(escape("\n"))
+		}
+		sb.WriteString(rendered)
+	}
+	return sb.String()
+}
+
+// tableHTML renders a table as one line of HTML so that it fits in a Markdown table cell.
+// 셀 병합은 colspan/rowspan으로, 제목 행과 제목 열은 <th>로 출력한다.
+func tableHTML(t *ir.TableBlock) string {
+	escape := func(s string) string {
+		s = html.EscapeString(s)
+		s = strings.ReplaceAll(s, "|", "&#124;")
+		return strings.ReplaceAll(s, "\n", "<br>")
+	}
+
+	covered := make([][]bool, len(t.Cells))
+	for i := range t.Cells {
+		covered[i] = make([]bool, len(t.Cells[i]))
+	}
+
+	var sb strings.Builder
+	sb.WriteString("<table>")
+	for i, row := range t.Cells {
+		sb.WriteString("<tr>")
+		for j, cell := range row {
+			if covered[i][j] {
+				continue
+			}
+			rowSpan, colSpan := max(cell.RowSpan, 1), max(cell.ColSpan, 1)
+			for r := i; r < i+rowSpan && r < len(covered); r++ {
+				for c := j; c < j+colSpan && c < len(covered[r]); c++ {
+					covered[r][c] = true
+				}
 			}
-			sb.WriteString("\n")
+
+			tag := "td"
+			if (i == 0 && t.HasHeader) || (j == 0 && t.HeaderColumn && cell.Style.IsHeader) {
+				tag = "th"
+			}
+			sb.WriteString("<" + tag)
+			if colSpan > 1 {
+				fmt.Fprintf(&sb, ` colspan="%d"`, colSpan)
+			}
+			if rowSpan > 1 {
+				fmt.Fprintf(&sb, ` rowspan="%d"`, rowSpan)
+			}
+			sb.WriteString(">")
+			sb.WriteString(renderNestedTables(cell, func(s string) string {
+				// 셀 텍스트에 Markdown으로 넣은 그림은 HTML 안에서 보이도록 <img>로 바꾼다
+				s = escape(s)
+				for _, img := range cell.Images {
+					s = strings.Replace(s, escape(img.Markdown()), imageHTML(img), 1)
+				}
+				return s
+			}, tableHTML))
+			sb.WriteString("</" + tag + ">")
+		}
+		sb.WriteString("</tr>")
+	}
+	sb.WriteString("</table>")
+	return sb.String()
+}
+
+// imageHTML renders an image as an <img> element.
+func imageHTML(img *ir.ImageBlock) string {
+	alt := img.Alt
+	if alt == "" {
+		alt = img.ID
+	}
+	path := img.Path
+	if path == "" {
+		path = img.ID
+	}
+	return `<img src="` + html.EscapeString(path) + `" alt="` + html.EscapeString(alt) + `">`
+}
+
+// writeMarkdownTableSeparator writes the header separator row with GFM column alignment markers.
+func writeMarkdownTableSeparator(sb *strings.Builder, alignments []string) {
+	sb.WriteString("|")
+	for _, align := range alignments {
+		switch align {
+		case ir.AlignLeft:
+			sb.WriteString(" :--- |")
+		case ir.AlignCenter:
+			sb.WriteString(" :---: |")
+		case ir.AlignRight:
+			sb.WriteString(" ---: |")
+		default:
+			sb.WriteString(" --- |")
 		}
 	}
 	sb.WriteString("\n")
@@ -704,35 +1229,100 @@ func splitByNumberedItems(content string) []string {
 	return result
 }
 
+// writeMarkdownTextBox writes a text box as a blockquote.
+func writeMarkdownTextBox(sb *strings.Builder, t *ir.TextBoxBlock) {
+	var inner strings.Builder
+	for _, p := range t.Paragraphs {
+		writeMarkdownParagraph(&inner, p)
+	}
+
+	content := strings.TrimSpace(inner.String())
+	if content == "" {
+		return
+	}
+	for _, line := range strings.Split(content, "\n") {
+		if line == "" {
+			sb.WriteString(">\n")
+			continue
+		}
+		sb.WriteString("> " + line + "\n")
+	}
+	sb.WriteString("\n")
+}
+
+// writeMarkdownMath writes a display equation as a $$ block.
+func writeMarkdownMath(sb *strings.Builder, m *ir.MathBlock) {
+	if strings.TrimSpace(m.TeX) == "" {
+		return
+	}
+	sb.WriteString("$$\n" + m.TeX + "\n$$\n\n")
+}
+
+// writeMarkdownImage writes a picture followed by its caption line.
+// 캡션 줄이 따로 나오므로 대체 텍스트가 없으면 캡션 대신 ID를 쓴다.
 func writeMarkdownImage(sb *strings.Builder, img *ir.ImageBlock) {
-	alt := img.Alt
-	if alt == "" {
-		alt = img.ID
+	shown := img
+	if img.Alt == "" && strings.TrimSpace(img.Caption) != "" {
+		withID := *img
+		withID.Alt = img.ID
+		shown = &withID
 	}
-	path := img.Path
+	sb.WriteString(shown.Markdown() + "\n\n")
+	writeMarkdownCaption(sb, img.Caption)
+}
+
+// writeMarkdownCaption writes a table or picture caption as an emphasized line.
+// 표 캡션은 표 앞에, 그림 캡션은 그림 뒤에 둔다.
+func writeMarkdownCaption(sb *strings.Builder, caption string) {
+	caption = strings.Join(strings.Fields(caption), " ")
+	if caption == "" {
+		return
+	}
+	sb.WriteString("*" + caption + "*\n\n")
+}
+
+// writeMarkdownAttachment writes an embedded file as a link to the extracted file.
+func writeMarkdownAttachment(sb *strings.Builder, a *ir.AttachmentBlock) {
+	label := a.Name
+	if a.Caption != "" {
+		label = a.Caption
+	}
+	path := a.Path
 	if path == "" {
-		path = img.ID
+		path = a.Name
 	}
-	sb.WriteString(fmt.Sprintf("![%s](%s)\n\n", alt, path))
+	sb.WriteString(fmt.Sprintf("[첨부: %s](%s)\n\n", label, path))
 }
 
 func writeMarkdownList(sb *strings.Builder, l *ir.ListBlock) {
-	writeListItems(sb, l.Items, l.Ordered, 0)
+	writeListItems(sb, l.Items, l.Ordered, l.Start, "")
 	sb.WriteString("\n")
 }
 
-func writeListItems(sb *strings.Builder, items []ir.ListItem, ordered bool, depth int) {
-	indent := strings.Repeat("  ", depth)
+// writeListItems writes list items; nested items are indented to the parent's content column.
+func writeListItems(sb *strings.Builder, items []ir.ListItem, ordered bool, start int, indent string) {
+	if start < 1 {
+		start = 1
+	}
 	for i, item := range items {
 		prefix := "- "
 		if ordered {
-			prefix = fmt.Sprintf("%d. ", i+1)
+			prefix = fmt.Sprintf("%d. ", start+i)
 		}
-		sb.WriteString(fmt.Sprintf("%s%s%s\n", indent, prefix, item.Text))
+
+		text := item.Text
+		if item.HasStyledRuns() {
+			text = strings.TrimSpace(formatRunsMarkdown(item.Runs))
+		}
+		sb.WriteString(fmt.Sprintf("%s%s%s\n", indent, prefix, text))
 
 		// Handle nested items
+		childIndent := indent + strings.Repeat(" ", len(prefix))
 		if len(item.Children) > 0 {
-			writeListItems(sb, item.Children, ordered, depth+1)
+			writeListItems(sb, item.Children, ordered, 1, childIndent)
+		}
+		for sub := item.Sublist; sub != nil; sub = sub.Next {
+			writeListItems(sb, sub.Items, sub.Ordered, sub.Start, childIndent)
 		}
 	}
 }
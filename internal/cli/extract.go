@@ -17,6 +17,8 @@ var (
 	extractFormat      string
 	extractImagesFlag  bool
 	extractImagesDir   string
+	extractAttachments bool
+	extractAttsDir     string
 	extractPrettyPrint bool
 )
 
@@ -42,6 +44,8 @@ func init() {
 	extractCmd.Flags().StringVarP(&extractFormat, "format", "f", "json", "출력 형식 (json, text)")
 	extractCmd.Flags().BoolVar(&extractImagesFlag, "extract-images", false, "이미지 추출 활성화")
 	extractCmd.Flags().StringVar(&extractImagesDir, "images-dir", "./images", "추출된 이미지 저장 디렉토리")
+	extractCmd.Flags().BoolVar(&extractAttachments, "extract-attachments", false, "삽입된 OLE 개체(스프레드시트 등) 추출 활성화")
+	extractCmd.Flags().StringVar(&extractAttsDir, "attachments-dir", "./attachments", "추출된 첨부 파일 저장 디렉토리")
 	extractCmd.Flags().BoolVar(&extractPrettyPrint, "pretty", true, "JSON 들여쓰기 적용")
 
 	rootCmd.AddCommand(extractCmd)
@@ -88,8 +92,10 @@ func runExtract(cmd *cobra.Command, args []string) error {
 
 func parseDocument(path string, format parser.Format) (*ir.Document, error) {
 	opts := parser.Options{
-		ExtractImages: extractImagesFlag,
-		ImageDir:      extractImagesDir,
+		ExtractImages:      extractImagesFlag,
+		ImageDir:           extractImagesDir,
+		ExtractAttachments: extractAttachments,
+		AttachmentDir:      extractAttsDir,
 	}
 
 	switch format {
@@ -169,6 +175,21 @@ func formatAsText(doc *ir.Document) string {
 			if block.List != nil {
 				result += formatListAsText(block.List) + "\n"
 			}
+		case ir.BlockTypeMath:
+			if block.Math != nil {
+				result += block.Math.TeX + "\n\n"
+			}
+		case ir.BlockTypeTextBox:
+			if block.TextBox != nil {
+				for _, p := range block.TextBox.Paragraphs {
+					result += p.Text + "\n"
+				}
+				result += "\n"
+			}
+		case ir.BlockTypeAttachment:
+			if block.Attachment != nil {
+				result += fmt.Sprintf("[첨부: %s]\n\n", block.Attachment.Name)
+			}
 		}
 	}
 
@@ -199,13 +220,27 @@ func formatTableAsText(table *ir.TableBlock) string {
 }
 
 func formatListAsText(list *ir.ListBlock) string {
+	return formatListItemsAsText(list.Items, list.Ordered, list.Start, "")
+}
+
+func formatListItemsAsText(items []ir.ListItem, ordered bool, start int, indent string) string {
+	if start < 1 {
+		start = 1
+	}
 	var result string
-	for i, item := range list.Items {
+	for i, item := range items {
 		prefix := "- "
-		if list.Ordered {
-			prefix = fmt.Sprintf("%d. ", i+1)
+		if ordered {
+			prefix = fmt.Sprintf("%d. ", start+i)
+		}
+		result += indent + prefix + item.Text + "\n"
+
+		if len(item.Children) > 0 {
+			result += formatListItemsAsText(item.Children, ordered, 1, indent+"  ")
+		}
+		for sub := item.Sublist; sub != nil; sub = sub.Next {
+			result += formatListItemsAsText(sub.Items, sub.Ordered, sub.Start, indent+"  ")
 		}
-		result += prefix + item.Text + "\n"
 	}
 	return result
 }
@@ -0,0 +1,148 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/roboco-io/hwp2md/internal/ir"
+	"github.com/roboco-io/hwp2md/internal/parser"
+	"github.com/roboco-io/hwp2md/internal/parser/hwp5"
+	"github.com/roboco-io/hwp2md/internal/parser/hwpx"
+	"github.com/spf13/cobra"
+)
+
+var (
+	fieldsOutput      string
+	fieldsPrettyPrint bool
+)
+
+var fieldsCmd = &cobra.Command{
+	Use:   "fields <file>",
+	Short: "HWP/HWPX 양식의 누름틀과 양식 개체 값 추출",
+	Long: `HWP/HWPX 문서에서 누름틀(클릭하여 입력)과 양식 개체(체크 박스, 라디오 단추,
+입력 상자, 콤보 상자 등)에 입력된 값을 추출합니다.
+
+필드 이름을 키로 하는 JSON 객체를 출력하며, 각 값에는 종류와 위치
+(본문 블록 번호, 추정 쪽 번호, 표 셀)가 함께 들어갑니다.
+이름이 없는 필드는 field1, field2 ... 로, 같은 이름이 반복되면 이름#2, 이름#3 ... 으로 구분합니다.
+
+예시:
+  hwp2md fields application.hwp
+  hwp2md fields application.hwpx -o fields.json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runFields,
+}
+
+func init() {
+	fieldsCmd.Flags().StringVarP(&fieldsOutput, "output", "o", "", "출력 파일 경로 (기본: stdout)")
+	fieldsCmd.Flags().BoolVar(&fieldsPrettyPrint, "pretty", true, "JSON 들여쓰기 적용")
+
+	rootCmd.AddCommand(fieldsCmd)
+}
+
+// fieldEntry is the JSON output of a form field.
+type fieldEntry struct {
+	Type     ir.FormFieldType `json:"type"`
+	Value    string           `json:"value"`
+	Caption  string           `json:"caption,omitempty"`
+	Hint     string           `json:"hint,omitempty"`
+	Location fieldLocation    `json:"location"`
+}
+
+// fieldLocation is where a form field is in the document.
+type fieldLocation struct {
+	Block int         `json:"block"`
+	Page  int         `json:"page,omitempty"`
+	Cell  *ir.CellRef `json:"cell,omitempty"`
+}
+
+func runFields(cmd *cobra.Command, args []string) error {
+	inputPath := args[0]
+
+	if _, err := os.Stat(inputPath); os.IsNotExist(err) {
+		return fmt.Errorf("파일을 찾을 수 없습니다: %s", inputPath)
+	}
+
+	format := parser.DetectFormat(inputPath)
+	if format == parser.FormatUnknown {
+		return fmt.Errorf("지원하지 않는 파일 형식입니다: %s", filepath.Ext(inputPath))
+	}
+
+	doc, err := parseDocumentForFields(inputPath, format)
+	if err != nil {
+		return fmt.Errorf("문서 파싱 실패: %w", err)
+	}
+
+	var data []byte
+	if fieldsPrettyPrint {
+		data, err = json.MarshalIndent(formFieldMap(doc.Fields), "", "  ")
+	} else {
+		data, err = json.Marshal(formFieldMap(doc.Fields))
+	}
+	if err != nil {
+		return fmt.Errorf("출력 포맷팅 실패: %w", err)
+	}
+
+	if fieldsOutput == "" {
+		fmt.Println(string(data))
+	} else {
+		if err := os.WriteFile(fieldsOutput, data, 0644); err != nil {
+			return fmt.Errorf("파일 저장 실패: %w", err)
+		}
+		fmt.Fprintf(cmd.ErrOrStderr(), "필드 추출 완료: %s (%d개)\n", fieldsOutput, len(doc.Fields))
+	}
+
+	return nil
+}
+
+// parseDocumentForFields parses a document with the native parsers.
+func parseDocumentForFields(path string, format parser.Format) (*ir.Document, error) {
+	switch format {
+	case parser.FormatHWPX:
+		p, err := hwpx.New(path, parser.Options{})
+		if err != nil {
+			return nil, err
+		}
+		defer p.Close()
+		return p.Parse()
+
+	case parser.FormatHWP:
+		p, err := hwp5.New(path, parser.Options{})
+		if err != nil {
+			return nil, err
+		}
+		defer p.Close()
+		return p.Parse()
+
+	default:
+		return nil, fmt.Errorf("알 수 없는 형식: %s", format)
+	}
+}
+
+// formFieldMap maps field names to their values.
+// 이름이 없는 필드는 순서대로 field1, field2 ...가 되고, 반복되는 이름에는 #2, #3 ...을 붙인다.
+func formFieldMap(fields []*ir.FormField) map[string]fieldEntry {
+	result := make(map[string]fieldEntry, len(fields))
+	seen := make(map[string]int)
+	for i, f := range fields {
+		name := f.Name
+		if name == "" {
+			name = fmt.Sprintf("field%d", i+1)
+		}
+		seen[name]++
+		if n := seen[name]; n > 1 {
+			name = fmt.Sprintf("%s#%d", name, n)
+		}
+
+		result[name] = fieldEntry{
+			Type:     f.Type,
+			Value:    f.Value,
+			Caption:  f.Caption,
+			Hint:     f.Hint,
+			Location: fieldLocation{Block: f.Block, Page: f.Page, Cell: f.Cell},
+		}
+	}
+	return result
+}
@@ -0,0 +1,379 @@
+// Package equation converts Hancom equation scripts (HWP 수식 편집기 문법) to LaTeX.
+//
+// The script language is whitespace separated with {} grouping, for example
+// "{a+b} over {c}", "sqrt {x^2 + 1}", "sum from {i=1} to n i" or
+// "matrix{a & b # c & d}". Unknown words are passed through unchanged.
+package equation
+
+import (
+	"strings"
+	"unicode"
+)
+
+// ToLaTeX converts a Hancom equation script to a LaTeX math expression
+// (without the surrounding $ delimiters).
+func ToLaTeX(script string) string {
+	p := &parser{tokens: tokenize(script)}
+
+	// 최상위의 #은 줄바꿈, &는 줄 맞춤 위치
+	var rows [][]string
+	cells := []string{}
+	aligned := false
+	for !p.eof() {
+		cell := p.parseExpr(isRowSeparator)
+		switch p.next() {
+		case "&":
+			cells = append(cells, cell)
+			aligned = true
+		case "#":
+			rows = append(rows, append(cells, cell))
+			cells = []string{}
+		default:
+			// 끝 또는 짝이 맞지 않는 '}'
+			cells = append(cells, cell)
+		}
+	}
+	if len(cells) > 0 || len(rows) == 0 {
+		rows = append(rows, cells)
+	}
+
+	if len(rows) == 1 && !aligned {
+		return clean(strings.Join(rows[0], " "))
+	}
+
+	env := "gathered"
+	if aligned {
+		env = "aligned"
+	}
+	return clean(`\begin{` + env + `} ` + joinRows(rows) + ` \end{` + env + `}`)
+}
+
+// joinRows joins table-like rows with & and \\.
+func joinRows(rows [][]string) string {
+	lines := make([]string, 0, len(rows))
+	for _, row := range rows {
+		lines = append(lines, strings.Join(row, " & "))
+	}
+	return strings.Join(lines, ` \\ `)
+}
+
+// clean collapses repeated spaces.
+func clean(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func isRowSeparator(tok string) bool {
+	return tok == "&" || tok == "#"
+}
+
+// parser is a recursive descent parser over script tokens.
+type parser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *parser) eof() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *parser) peek() string {
+	if p.eof() {
+		return ""
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() string {
+	tok := p.peek()
+	if !p.eof() {
+		p.pos++
+	}
+	return tok
+}
+
+// peekKeyword returns the next token in lower case for keyword matching.
+func (p *parser) peekKeyword() string {
+	return strings.ToLower(p.peek())
+}
+
+// parseExpr parses a sequence of items until '}', the end, or a token accepted by stop.
+// 중위 연산자 over/atop은 바로 앞 항목과 다음 항목을 분수로 묶는다.
+func (p *parser) parseExpr(stop func(string) bool) string {
+	var items []string
+	for !p.eof() {
+		tok := p.peek()
+		if tok == "}" || (stop != nil && stop(tok)) {
+			break
+		}
+
+		switch strings.ToLower(tok) {
+		case "over", "atop":
+			p.next()
+			num := ""
+			if len(items) > 0 {
+				num = items[len(items)-1]
+				items = items[:len(items)-1]
+			}
+			den := p.parsePiece()
+			if strings.EqualFold(tok, "atop") {
+				items = append(items, `\genfrac{}{}{0pt}{}`+braced(num)+braced(den))
+			} else {
+				items = append(items, `\frac`+braced(num)+braced(den))
+			}
+		default:
+			items = append(items, p.parsePiece())
+		}
+	}
+	return strings.Join(items, " ")
+}
+
+// parsePiece parses a primary followed by any superscripts and subscripts.
+func (p *parser) parsePiece() string {
+	base := p.parsePrimary()
+	for {
+		switch p.peekKeyword() {
+		case "^", "sup":
+			p.next()
+			base += "^" + braced(p.parsePrimary())
+		case "_", "sub":
+			p.next()
+			base += "_" + braced(p.parsePrimary())
+		default:
+			return base
+		}
+	}
+}
+
+// parsePrimary parses a single item: a group, command with arguments, symbol or literal.
+func (p *parser) parsePrimary() string {
+	if p.eof() {
+		return ""
+	}
+
+	tok := p.next()
+	switch tok {
+	case "{":
+		inner := p.parseExpr(nil)
+		if p.peek() == "}" {
+			p.next()
+		}
+		return "{" + inner + "}"
+	case "^", "_":
+		// 밑이 없는 첨자
+		p.pos--
+		return "{}"
+	case "~":
+		return `\;`
+	case "`":
+		return `\,`
+	case "#":
+		return `\\`
+	case "&":
+		return "&"
+	case "%", "$":
+		return `\` + tok
+	case "\\":
+		return `\backslash`
+	}
+
+	if strings.HasPrefix(tok, `"`) {
+		return `\text{` + escapeText(strings.Trim(tok, `"`)) + `}`
+	}
+	if latex, ok := operators[tok]; ok {
+		return latex
+	}
+
+	first, _ := firstRune(tok)
+	switch {
+	case first > unicode.MaxASCII && unicode.IsLetter(first):
+		// 한글 등은 본문 글꼴로
+		return `\text{` + escapeText(tok) + `}`
+	case isASCIILetter(first):
+		return p.parseWord(tok)
+	}
+	return tok
+}
+
+// parseWord converts a keyword (and its arguments) or passes an identifier through.
+func (p *parser) parseWord(tok string) string {
+	lower := strings.ToLower(tok)
+
+	switch lower {
+	case "sqrt":
+		return `\sqrt` + braced(p.parsePrimary())
+	case "root":
+		n := p.parsePrimary()
+		if p.peekKeyword() == "of" {
+			p.next()
+		}
+		return `\sqrt[` + unbraced(n) + `]` + braced(p.parsePrimary())
+	case "left":
+		return p.parseLeftRight()
+	case "right":
+		// 짝이 없는 right
+		return delimiter(p.next())
+	}
+
+	if op, ok := bigOperators[lower]; ok {
+		return p.parseBigOperator(op)
+	}
+	if env, ok := matrices[lower]; ok {
+		return p.parseMatrix(env)
+	}
+	if cmd, ok := accents[lower]; ok {
+		return cmd + braced(p.parsePrimary())
+	}
+	if cmd, ok := fonts[lower]; ok {
+		return cmd + braced(p.parsePrimary())
+	}
+	if arrow, ok := arrows[lower]; ok {
+		if unicode.IsUpper(rune(tok[0])) {
+			return arrow[1]
+		}
+		return arrow[0]
+	}
+
+	// 그리스 문자: 소문자 이름은 소문자, 대문자로 시작하면 대문자
+	if letter, ok := greekLower[tok]; ok {
+		return letter
+	}
+	if letter, ok := greekUpper[lower]; ok && unicode.IsUpper(rune(tok[0])) {
+		return letter
+	}
+	if letter, ok := greekLower[lower]; ok {
+		return letter
+	}
+
+	if fn, ok := functions[lower]; ok {
+		return fn
+	}
+	if sym, ok := symbols[lower]; ok {
+		return sym
+	}
+	return tok
+}
+
+// parseBigOperator parses limits given with from/to after a big operator.
+// _ and ^ limits are handled by parsePiece.
+func (p *parser) parseBigOperator(op string) string {
+	if p.peekKeyword() == "from" {
+		p.next()
+		op += "_" + braced(p.parsePrimary())
+	}
+	if p.peekKeyword() == "to" {
+		p.next()
+		op += "^" + braced(p.parsePrimary())
+	}
+	return op
+}
+
+// parseLeftRight parses "left <delim> ... right <delim>".
+func (p *parser) parseLeftRight() string {
+	open := delimiter(p.next())
+	body := p.parseExpr(func(tok string) bool {
+		return strings.EqualFold(tok, "right")
+	})
+	closing := "."
+	if p.peekKeyword() == "right" {
+		p.next()
+		closing = delimiter(p.next())
+	}
+	return `\left` + open + " " + body + ` \right` + closing
+}
+
+// parseMatrix parses "<kind> { a & b # c & d }" into a LaTeX environment.
+func (p *parser) parseMatrix(env string) string {
+	if p.peek() != "{" {
+		return `\begin{` + env + `} ` + unbraced(p.parsePrimary()) + ` \end{` + env + `}`
+	}
+	p.next()
+
+	var rows [][]string
+	var cells []string
+	for {
+		cell := p.parseExpr(isRowSeparator)
+		cells = append(cells, cell)
+
+		tok := p.next()
+		if tok == "&" {
+			continue
+		}
+		rows = append(rows, cells)
+		cells = nil
+		if tok != "#" {
+			// '}' 또는 끝
+			break
+		}
+	}
+
+	return `\begin{` + env + `} ` + joinRows(rows) + ` \end{` + env + `}`
+}
+
+// delimiter converts a left/right delimiter token.
+func delimiter(tok string) string {
+	if d, ok := delimiters[strings.ToLower(tok)]; ok {
+		return d
+	}
+	if tok == "" {
+		return "."
+	}
+	return tok
+}
+
+// braced wraps s in braces unless it already is a single group.
+func braced(s string) string {
+	if isGroup(s) {
+		return s
+	}
+	return "{" + s + "}"
+}
+
+// unbraced removes the braces of a single group.
+func unbraced(s string) string {
+	if isGroup(s) {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
+// isGroup reports whether s is exactly one balanced {...} group.
+func isGroup(s string) bool {
+	if len(s) < 2 || s[0] != '{' || s[len(s)-1] != '}' {
+		return false
+	}
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++ // \{ \} 등 이스케이프
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 && i != len(s)-1 {
+				return false
+			}
+		}
+	}
+	return depth == 0
+}
+
+// escapeText escapes LaTeX special characters inside \text{}.
+func escapeText(s string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\textbackslash{}`, "{", `\{`, "}", `\}`,
+		"$", `\$`, "%", `\%`, "&", `\&`, "#", `\#`, "_", `\_`,
+	)
+	return replacer.Replace(s)
+}
+
+func firstRune(s string) (rune, bool) {
+	for _, r := range s {
+		return r, true
+	}
+	return 0, false
+}
+
+func isASCIILetter(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
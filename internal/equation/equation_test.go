@@ -0,0 +1,46 @@
+package equation
+
+import "testing"
+
+func TestToLaTeX(t *testing.T) {
+	tests := []struct {
+		name     string
+		script   string
+		expected string
+	}{
+		{"fraction", "{a} over {b}", `\frac{a}{b}`},
+		{"fraction without braces", "1 over 2", `\frac{1}{2}`},
+		{"fraction of sums", "{a+b} over {c-d}", `\frac{a + b}{c - d}`},
+		{"sqrt", "sqrt {x^2 + 1}", `\sqrt{x^{2} + 1}`},
+		{"root", "root 3 of x", `\sqrt[3]{x}`},
+		{"superscript and subscript", "x_1 ^2", `x_{1}^{2}`},
+		{"sum from to", "sum from {i=1} to n i", `\sum_{i = 1}^{n} i`},
+		{"sum with scripts", "SUM _{k=0} ^{inf} a_k", `\sum_{k = 0}^{\infty} a_{k}`},
+		{"integral", "int from 0 to 1 f(x) dx", `\int_{0}^{1} f ( x ) dx`},
+		{"limit", "lim from {x -> 0} {sin x} over x", `\lim_{x \rightarrow 0} \frac{\sin x}{x}`},
+		{"matrix", "matrix{a & b # c & d}", `\begin{matrix} a & b \\ c & d \end{matrix}`},
+		{"pmatrix", "pmatrix{1 & 0 # 0 & 1}", `\begin{pmatrix} 1 & 0 \\ 0 & 1 \end{pmatrix}`},
+		{"cases", "cases{x & x>=0 # -x & x<0}", `\begin{cases} x & x \ge 0 \\ - x & x < 0 \end{cases}`},
+		{"left right", "LEFT ( a over b RIGHT )", `\left( \frac{a}{b} \right)`},
+		{"greek", "alpha + BETA + Gamma", `\alpha + B + \Gamma`},
+		{"operators", "a times b <= c", `a \times b \le c`},
+		{"arrows", "p rarrow q RARROW r", `p \rightarrow q \Rightarrow r`},
+		{"functions", "sin theta cdot cos theta", `\sin \theta \cdot \cos \theta`},
+		{"accent", "bar x + vec {AB}", `\overline{x} + \vec{AB}`},
+		{"text", `x "if" y`, `x \text{if} y`},
+		{"korean", "넓이 = 가로 times 세로", `\text{넓이} = \text{가로} \times \text{세로}`},
+		{"spacing", "a ~ b `c", `a \; b \, c`},
+		{"line break", "a=b # c=d", `\begin{gathered} a = b \\ c = d \end{gathered}`},
+		{"aligned", "a &= b # &= c", `\begin{aligned} a & = b \\ & = c \end{aligned}`},
+		{"unbalanced", "{a over b", `{\frac{a}{b}}`},
+		{"empty", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ToLaTeX(tt.script); got != tt.expected {
+				t.Errorf("ToLaTeX(%q) = %q, want %q", tt.script, got, tt.expected)
+			}
+		})
+	}
+}
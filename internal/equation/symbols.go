@@ -0,0 +1,115 @@
+package equation
+
+// greekLower maps lower-case Greek letter names to LaTeX commands.
+var greekLower = map[string]string{
+	"alpha": `\alpha`, "beta": `\beta`, "gamma": `\gamma`, "delta": `\delta`,
+	"epsilon": `\epsilon`, "varepsilon": `\varepsilon`, "zeta": `\zeta`, "eta": `\eta`,
+	"theta": `\theta`, "vartheta": `\vartheta`, "iota": `\iota`, "kappa": `\kappa`,
+	"lambda": `\lambda`, "mu": `\mu`, "nu": `\nu`, "xi": `\xi`, "omicron": `o`,
+	"pi": `\pi`, "varpi": `\varpi`, "rho": `\rho`, "varrho": `\varrho`,
+	"sigma": `\sigma`, "varsigma": `\varsigma`, "tau": `\tau`, "upsilon": `\upsilon`,
+	"phi": `\phi`, "varphi": `\varphi`, "chi": `\chi`, "psi": `\psi`, "omega": `\omega`,
+}
+
+// greekUpper maps upper-case Greek letter names to LaTeX.
+// LaTeX has no commands for letters that look like Latin capitals, so those map to the Latin letter.
+var greekUpper = map[string]string{
+	"alpha": "A", "beta": "B", "gamma": `\Gamma`, "delta": `\Delta`,
+	"epsilon": "E", "zeta": "Z", "eta": "H", "theta": `\Theta`,
+	"iota": "I", "kappa": "K", "lambda": `\Lambda`, "mu": "M", "nu": "N",
+	"xi": `\Xi`, "omicron": "O", "pi": `\Pi`, "rho": "P", "sigma": `\Sigma`,
+	"tau": "T", "upsilon": `\Upsilon`, "phi": `\Phi`, "chi": "X", "psi": `\Psi`,
+	"omega": `\Omega`,
+}
+
+// symbols maps case-insensitive keywords to LaTeX symbols.
+var symbols = map[string]string{
+	// 연산자
+	"times": `\times`, "div": `\div`, "pm": `\pm`, "mp": `\mp`,
+	"cdot": `\cdot`, "circ": `\circ`, "bullet": `\bullet`, "ast": `\ast`, "star": `\star`,
+	"oplus": `\oplus`, "ominus": `\ominus`, "otimes": `\otimes`, "odot": `\odot`,
+	"cup": `\cup`, "cap": `\cap`, "sqcup": `\sqcup`, "sqcap": `\sqcap`,
+	"wedge": `\wedge`, "vee": `\vee`, "land": `\land`, "lor": `\lor`, "neg": `\neg`, "lnot": `\neg`,
+
+	// 관계
+	"le": `\le`, "leq": `\le`, "ge": `\ge`, "geq": `\ge`, "ne": `\ne`, "neq": `\ne`,
+	"ll": `\ll`, "gg": `\gg`, "approx": `\approx`, "sim": `\sim`, "simeq": `\simeq`,
+	"cong": `\cong`, "equiv": `\equiv`, "propto": `\propto`, "doteq": `\doteq`,
+	"in": `\in`, "notin": `\notin`, "owns": `\ni`, "ni": `\ni`,
+	"subset": `\subset`, "supset": `\supset`, "subseteq": `\subseteq`, "supseteq": `\supseteq`,
+	"perp": `\perp`, "parallel": `\parallel`, "prec": `\prec`, "succ": `\succ`,
+
+	// 기호
+	"inf": `\infty`, "infty": `\infty`, "infinity": `\infty`,
+	"partial": `\partial`, "nabla": `\nabla`, "forall": `\forall`,
+	"exist": `\exists`, "exists": `\exists`, "emptyset": `\emptyset`,
+	"angle": `\angle`, "triangle": `\triangle`, "therefore": `\therefore`, "because": `\because`,
+	"cdots": `\cdots`, "ldots": `\ldots`, "vdots": `\vdots`, "ddots": `\ddots`,
+	"prime": `'`, "deg": `^{\circ}`, "hbar": `\hbar`, "ell": `\ell`, "aleph": `\aleph`,
+	"lbrace": `\{`, "rbrace": `\}`, "vert": `|`, "dline": `\|`,
+	"uparrow": `\uparrow`, "downarrow": `\downarrow`, "updownarrow": `\updownarrow`,
+	"mapsto": `\mapsto`, "to": `\to`,
+}
+
+// arrows maps arrow keywords to (single, double) LaTeX arrows.
+// 대문자로 시작하면 겹화살표가 된다 (rarrow → →, RARROW → ⇒).
+var arrows = map[string][2]string{
+	"rarrow":  {`\rightarrow`, `\Rightarrow`},
+	"larrow":  {`\leftarrow`, `\Leftarrow`},
+	"lrarrow": {`\leftrightarrow`, `\Leftrightarrow`},
+	"uarrow":  {`\uparrow`, `\Uparrow`},
+	"darrow":  {`\downarrow`, `\Downarrow`},
+}
+
+// functions are upright function names with LaTeX commands.
+var functions = map[string]string{
+	"sin": `\sin`, "cos": `\cos`, "tan": `\tan`, "cot": `\cot`, "sec": `\sec`, "csc": `\csc`,
+	"arcsin": `\arcsin`, "arccos": `\arccos`, "arctan": `\arctan`,
+	"sinh": `\sinh`, "cosh": `\cosh`, "tanh": `\tanh`, "coth": `\coth`,
+	"log": `\log`, "ln": `\ln`, "lg": `\lg`, "exp": `\exp`,
+	"det": `\det`, "max": `\max`, "min": `\min`, "gcd": `\gcd`, "mod": `\bmod`,
+	"arg": `\arg`, "dim": `\dim`, "ker": `\ker`,
+}
+
+// bigOperators can take limits with from/to (or _/^).
+var bigOperators = map[string]string{
+	"sum": `\sum`, "prod": `\prod`, "coprod": `\coprod`,
+	"int": `\int`, "iint": `\iint`, "iiint": `\iiint`, "oint": `\oint`,
+	"lim": `\lim`, "bigcup": `\bigcup`, "bigcap": `\bigcap`, "union": `\bigcup`, "inter": `\bigcap`,
+	"bigoplus": `\bigoplus`, "bigotimes": `\bigotimes`, "bigwedge": `\bigwedge`, "bigvee": `\bigvee`,
+}
+
+// accents wrap their argument.
+var accents = map[string]string{
+	"bar": `\overline`, "overline": `\overline`, "under": `\underline`, "underline": `\underline`,
+	"hat": `\hat`, "widehat": `\widehat`, "check": `\check`, "tilde": `\tilde`,
+	"vec": `\vec`, "dot": `\dot`, "ddot": `\ddot`, "acute": `\acute`, "grave": `\grave`,
+	"dyad": `\overleftrightarrow`,
+}
+
+// fonts change the style of their argument.
+var fonts = map[string]string{
+	"rm": `\mathrm`, "it": `\mathit`, "bold": `\mathbf`, "bf": `\mathbf`,
+}
+
+// matrices maps matrix-like keywords to LaTeX environments.
+var matrices = map[string]string{
+	"matrix": "matrix", "pmatrix": "pmatrix", "bmatrix": "bmatrix", "dmatrix": "vmatrix",
+	"cases": "cases", "pile": "matrix", "lpile": "matrix", "rpile": "matrix", "eqalign": "aligned",
+}
+
+// operators maps multi-character operator tokens to LaTeX.
+var operators = map[string]string{
+	"<=": `\le`, ">=": `\ge`, "!=": `\ne`, "==": `\equiv`,
+	"->": `\rightarrow`, "<-": `\leftarrow`, "<->": `\leftrightarrow`,
+	"=>": `\Rightarrow`, "<=>": `\Leftrightarrow`,
+	"+-": `\pm`, "-+": `\mp`, "...": `\cdots`,
+}
+
+// delimiters maps left/right delimiter tokens to LaTeX.
+var delimiters = map[string]string{
+	"(": "(", ")": ")", "[": "[", "]": "]", "|": "|", ".": ".",
+	"{": `\{`, "}": `\}`, "lbrace": `\{`, "rbrace": `\}`,
+	"<": `\langle`, ">": `\rangle`, "langle": `\langle`, "rangle": `\rangle`,
+	"dline": `\|`, "lceil": `\lceil`, "rceil": `\rceil`, "lfloor": `\lfloor`, "rfloor": `\rfloor`,
+}
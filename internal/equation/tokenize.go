@@ -0,0 +1,74 @@
+package equation
+
+import (
+	"strings"
+	"unicode"
+)
+
+// multiCharOperators are operator tokens longer than one character, longest first.
+var multiCharOperators = []string{"<=>", "<->", "...", "<=", ">=", "!=", "==", "->", "<-", "=>", "+-", "-+"}
+
+// tokenize splits an equation script into tokens.
+// 영문 단어, 숫자, 따옴표 문자열, 한글 등 문자 묶음, 연산자, 기호 하나가 각각 토큰이 된다.
+func tokenize(script string) []string {
+	runes := []rune(script)
+	var tokens []string
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+
+		case r == '"':
+			// 따옴표 안은 그대로 (닫는 따옴표가 없으면 끝까지)
+			end := i + 1
+			for end < len(runes) && runes[end] != '"' {
+				end++
+			}
+			if end < len(runes) {
+				end++
+			}
+			tokens = append(tokens, `"`+strings.Trim(string(runes[i:end]), `"`)+`"`)
+			i = end
+
+		case isASCIILetter(r):
+			end := i + 1
+			for end < len(runes) && isASCIILetter(runes[end]) {
+				end++
+			}
+			tokens = append(tokens, string(runes[i:end]))
+			i = end
+
+		case unicode.IsDigit(r):
+			end := i + 1
+			for end < len(runes) && (unicode.IsDigit(runes[end]) || runes[end] == '.') {
+				end++
+			}
+			tokens = append(tokens, string(runes[i:end]))
+			i = end
+
+		case r > unicode.MaxASCII && unicode.IsLetter(r):
+			end := i + 1
+			for end < len(runes) && runes[end] > unicode.MaxASCII && unicode.IsLetter(runes[end]) {
+				end++
+			}
+			tokens = append(tokens, string(runes[i:end]))
+			i = end
+
+		default:
+			tok := string(r)
+			for _, op := range multiCharOperators {
+				if strings.HasPrefix(string(runes[i:]), op) {
+					tok = op
+					break
+				}
+			}
+			tokens = append(tokens, tok)
+			i += len([]rune(tok))
+		}
+	}
+
+	return tokens
+}
@@ -0,0 +1,36 @@
+package ir
+
+import "strings"
+
+// AnchorID converts a bookmark name (책갈피 이름) to an anchor ID usable as an HTML id and a
+// Markdown link target (#id). Whitespace becomes '-' and characters that break links are removed;
+// Hangul and other letters are kept.
+func AnchorID(name string) string {
+	name = strings.Map(func(r rune) rune {
+		switch r {
+		case '"', '\'', '<', '>', '(', ')', '[', ']', '{', '}', '#', '\\':
+			return -1
+		}
+		return r
+	}, name)
+	return strings.Join(strings.Fields(name), "-")
+}
+
+// AddAnchor registers a bookmark. The anchor is attached to the next block added to the document,
+// so parsers call it before adding the block that holds the bookmark.
+func (d *Document) AddAnchor(name string) {
+	if id := AnchorID(name); id != "" {
+		d.pendingAnchors = append(d.pendingAnchors, id)
+	}
+}
+
+// FlushAnchors attaches the bookmarks still waiting for a block to the last block,
+// so bookmarks at the end of the document are kept. Parsers call it once all blocks are added.
+func (d *Document) FlushAnchors() {
+	if len(d.pendingAnchors) == 0 || len(d.Content) == 0 {
+		return
+	}
+	last := &d.Content[len(d.Content)-1]
+	last.Anchors = append(last.Anchors, d.pendingAnchors...)
+	d.pendingAnchors = nil
+}
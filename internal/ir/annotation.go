@@ -0,0 +1,234 @@
+package ir
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ChangeType is the kind of a tracked change (변경 내용 추적).
+type ChangeType string
+
+const (
+	ChangeInsert ChangeType = "insert"
+	ChangeDelete ChangeType = "delete"
+)
+
+// Comment represents a memo (메모) attached to a range of text.
+// The commented range is marked by runs whose Style.Comment holds the comment ID,
+// followed by a reference run whose Style.CommentRef holds the ID and whose text is Label().
+type Comment struct {
+	ID         string       `json:"id"`
+	Author     string       `json:"author,omitempty"`
+	Date       string       `json:"date,omitempty"`
+	Paragraphs []*Paragraph `json:"paragraphs"`
+}
+
+// AddComment registers a comment body and returns the reference run placed after the commented range.
+// Comments are numbered 1, 2, ... in document order.
+func (d *Document) AddComment(author, date string, paragraphs []*Paragraph) Run {
+	c := &Comment{
+		ID:         strconv.Itoa(len(d.Comments) + 1),
+		Author:     author,
+		Date:       date,
+		Paragraphs: paragraphs,
+	}
+	d.Comments = append(d.Comments, c)
+
+	return Run{Text: c.Label(), Style: TextStyle{CommentRef: c.ID}}
+}
+
+// Text returns the comment text with paragraphs joined by newlines.
+func (c *Comment) Text() string {
+	var texts []string
+	for _, p := range c.Paragraphs {
+		if text := strings.TrimSpace(p.Text); text != "" {
+			texts = append(texts, text)
+		}
+	}
+	return strings.Join(texts, "\n")
+}
+
+// Label returns the comment text prefixed with its author ("author: text").
+func (c *Comment) Label() string {
+	text := strings.ReplaceAll(c.Text(), "\n", " ")
+	if c.Author == "" {
+		return text
+	}
+	return c.Author + ": " + text
+}
+
+// hasAnnotations returns true if any run is a tracked change or belongs to a comment.
+func hasAnnotations(runs []Run) bool {
+	for _, run := range runs {
+		if run.Style.Change != "" || run.Style.Comment != "" || run.Style.CommentRef != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// HasAnnotations returns true if the paragraph has tracked changes or comments.
+func (p *Paragraph) HasAnnotations() bool {
+	return hasAnnotations(p.Runs)
+}
+
+// RunsText returns the plain text of runs.
+// Inline equations are written as $...$, tracked changes and comments as CriticMarkup:
+// {++inserted++}, {--deleted--}, {==commented range==}{>>comment<<}.
+func RunsText(runs []Run) string {
+	var sb strings.Builder
+	comment := ""
+	for _, run := range runs {
+		if run.Style.Comment != comment {
+			if comment != "" {
+				sb.WriteString("==}")
+			}
+			if run.Style.Comment != "" {
+				sb.WriteString("{==")
+			}
+			comment = run.Style.Comment
+		}
+
+		switch {
+		case run.Style.CommentRef != "":
+			sb.WriteString("{>>" + run.Text + "<<}")
+		case run.Style.Math:
+			sb.WriteString(CriticChange(run.Style.Change, "$"+run.Text+"$"))
+		default:
+			sb.WriteString(CriticChange(run.Style.Change, run.Text))
+		}
+	}
+	if comment != "" {
+		sb.WriteString("==}")
+	}
+	return sb.String()
+}
+
+// CriticChange wraps text in the CriticMarkup of a tracked change ({++...++} or {--...--}).
+func CriticChange(change ChangeType, text string) string {
+	if text == "" {
+		return text
+	}
+	switch change {
+	case ChangeInsert:
+		return "{++" + text + "++}"
+	case ChangeDelete:
+		return "{--" + text + "--}"
+	}
+	return text
+}
+
+var (
+	criticInsert    = regexp.MustCompile(`(?s)\{\+\+(.*?)\+\+\}`)
+	criticDelete    = regexp.MustCompile(`(?s)\{--(.*?)--\}`)
+	criticComment   = regexp.MustCompile(`(?s)\{>>.*?<<\}`)
+	criticHighlight = regexp.MustCompile(`(?s)\{==(.*?)==\}`)
+)
+
+// ResolveCriticMarkup accepts (or rejects) the CriticMarkup changes in text and removes comments.
+// It is used for text that is stored without runs, such as table cells.
+func ResolveCriticMarkup(text string, accept bool) string {
+	keep, drop := criticInsert, criticDelete
+	if !accept {
+		keep, drop = criticDelete, criticInsert
+	}
+	text = drop.ReplaceAllString(text, "")
+	text = keep.ReplaceAllString(text, "$1")
+	text = criticComment.ReplaceAllString(text, "")
+	return criticHighlight.ReplaceAllString(text, "$1")
+}
+
+// ResolveChanges accepts (or rejects) all tracked changes of the document and removes its comments.
+// Accepting keeps inserted text and drops deleted text; rejecting does the opposite.
+func (d *Document) ResolveChanges(accept bool) {
+	for i := range d.Content {
+		block := &d.Content[i]
+		switch {
+		case block.Paragraph != nil:
+			resolveParagraph(block.Paragraph, accept)
+		case block.Table != nil:
+			resolveTable(block.Table, accept)
+		case block.List != nil:
+			resolveList(block.List, accept)
+		case block.TextBox != nil:
+			resolveParagraphs(block.TextBox.Paragraphs, accept)
+		}
+	}
+	for _, fn := range d.Footnotes {
+		resolveParagraphs(fn.Paragraphs, accept)
+	}
+	for _, lists := range [][]*HeaderFooter{d.Headers, d.Footers, d.MasterPages} {
+		for _, hf := range lists {
+			resolveParagraphs(hf.Paragraphs, accept)
+		}
+	}
+	d.Comments = nil
+}
+
+// resolveRuns drops the rejected runs and clears change and comment styles.
+func resolveRuns(runs []Run, accept bool) []Run {
+	dropped := ChangeDelete
+	if !accept {
+		dropped = ChangeInsert
+	}
+
+	var resolved []Run
+	for _, run := range runs {
+		if run.Style.Change == dropped || run.Style.CommentRef != "" {
+			continue
+		}
+		run.Style.Change = ""
+		run.Style.ChangeAuthor = ""
+		run.Style.Comment = ""
+		if n := len(resolved); n > 0 && !run.Style.Math && run.Style.FootnoteRef == "" && resolved[n-1].Style == run.Style {
+			resolved[n-1].Text += run.Text
+			continue
+		}
+		resolved = append(resolved, run)
+	}
+	return resolved
+}
+
+func resolveParagraphs(paragraphs []*Paragraph, accept bool) {
+	for _, p := range paragraphs {
+		resolveParagraph(p, accept)
+	}
+}
+
+func resolveParagraph(p *Paragraph, accept bool) {
+	if !p.HasAnnotations() {
+		return
+	}
+	p.Runs = resolveRuns(p.Runs, accept)
+	p.Text = strings.TrimSpace(RunsText(p.Runs))
+}
+
+func resolveTable(t *TableBlock, accept bool) {
+	for _, row := range t.Cells {
+		for i := range row {
+			row[i].Text = ResolveCriticMarkup(row[i].Text, accept)
+			for _, nested := range row[i].Tables {
+				resolveTable(nested, accept)
+			}
+		}
+	}
+}
+
+func resolveList(l *ListBlock, accept bool) {
+	var resolveItems func(items []ListItem)
+	resolveItems = func(items []ListItem) {
+		for i := range items {
+			item := &items[i]
+			if hasAnnotations(item.Runs) {
+				item.Runs = resolveRuns(item.Runs, accept)
+				item.Text = strings.TrimSpace(RunsText(item.Runs))
+			}
+			resolveItems(item.Children)
+			for sub := item.Sublist; sub != nil; sub = sub.Next {
+				resolveList(sub, accept)
+			}
+		}
+	}
+	resolveItems(l.Items)
+}
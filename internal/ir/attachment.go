@@ -0,0 +1,64 @@
+package ir
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// AttachmentBlock represents a file embedded in the document as an OLE object,
+// such as an Excel sheet or another document. The payload is unwrapped from its
+// OLE container, so Data holds the original file (e.g. an .xlsx package).
+type AttachmentBlock struct {
+	ID      string `json:"id"`                // internal object ID (e.g. BIN0003)
+	Name    string `json:"name"`              // file name of the payload
+	Path    string `json:"path,omitempty"`    // extracted file path
+	Format  string `json:"format,omitempty"`  // xlsx, xls, docx, doc, pptx, ole, etc.
+	Caption string `json:"caption,omitempty"` // object caption
+	Data    []byte `json:"-"`                 // raw payload (not serialized)
+}
+
+// NewAttachment creates a new attachment named after its ID and format.
+func NewAttachment(id, format string) *AttachmentBlock {
+	name := id
+	if format != "" {
+		name += "." + format
+	}
+	return &AttachmentBlock{
+		ID:     id,
+		Name:   name,
+		Format: format,
+	}
+}
+
+// HasData returns true if the attachment has its payload loaded.
+func (a *AttachmentBlock) HasData() bool {
+	return len(a.Data) > 0
+}
+
+// Save writes the payload to dir and records the written path.
+// Payloads keeping their original file name are prefixed with the object ID,
+// so objects with the same file name do not overwrite each other.
+func (a *AttachmentBlock) Save(dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	name := filepath.Base(a.Name)
+	if a.ID != "" && !strings.HasPrefix(name, a.ID) {
+		name = a.ID + "_" + name
+	}
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, a.Data, 0644); err != nil {
+		return err
+	}
+	a.Path = path
+	return nil
+}
+
+// AddAttachment adds an attachment block to the document.
+func (d *Document) AddAttachment(a *AttachmentBlock) {
+	d.addBlock(Block{
+		Type:       BlockTypeAttachment,
+		Attachment: a,
+	})
+}
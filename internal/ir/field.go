@@ -0,0 +1,63 @@
+package ir
+
+// FormFieldType is the kind of a form field.
+type FormFieldType string
+
+const (
+	FormFieldClickHere FormFieldType = "click_here" // 누름틀
+	FormFieldText      FormFieldType = "text"       // edit box
+	FormFieldCheckBox  FormFieldType = "checkbox"
+	FormFieldRadio     FormFieldType = "radio"
+	FormFieldComboBox  FormFieldType = "combobox"
+	FormFieldListBox   FormFieldType = "listbox"
+)
+
+// FormField is a fill-in field of a form (click-here field or form object) and its value.
+// Check boxes and radio buttons have the value "true" or "false"; their label is the caption.
+type FormField struct {
+	Name    string        `json:"name"`
+	Type    FormFieldType `json:"type"`
+	Value   string        `json:"value"`
+	Caption string        `json:"caption,omitempty"` // label of a check box or radio button
+	Hint    string        `json:"hint,omitempty"`    // guide text shown while a click-here field is empty
+	Block   int           `json:"block"`             // index in Document.Content of the block holding the field
+	Page    int           `json:"page,omitempty"`    // estimated page of that block
+	Cell    *CellRef      `json:"cell,omitempty"`    // table cell holding the field
+}
+
+// CellRef locates a cell in a table block.
+type CellRef struct {
+	Row int `json:"row"`
+	Col int `json:"col"`
+}
+
+// AddField records a form field located in the next block added to the document
+// (or, with a cell reference, in a cell of that table).
+func (d *Document) AddField(f *FormField) {
+	f.Block = len(d.Content)
+	f.Page = d.page
+	d.Fields = append(d.Fields, f)
+}
+
+// Checked reports whether a check box or radio button is selected.
+func (f *FormField) Checked() bool {
+	return f.Value == "true"
+}
+
+// InlineText returns the text shown for a form object in the body.
+// Click-here fields keep their text in place, so this is only used for form objects.
+func (f *FormField) InlineText() string {
+	switch f.Type {
+	case FormFieldCheckBox:
+		if f.Checked() {
+			return "☑ " + f.Caption
+		}
+		return "☐ " + f.Caption
+	case FormFieldRadio:
+		if f.Checked() {
+			return "◉ " + f.Caption
+		}
+		return "○ " + f.Caption
+	}
+	return f.Value
+}
@@ -0,0 +1,51 @@
+package ir
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Footnote represents the body of a footnote or endnote.
+// Its position in the text is marked by a run whose Style.FootnoteRef holds the note ID.
+type Footnote struct {
+	ID         string       `json:"id"`     // reference label (e.g. "1", "e1")
+	Number     int          `json:"number"` // note number within its kind
+	Endnote    bool         `json:"endnote,omitempty"`
+	Paragraphs []*Paragraph `json:"paragraphs"`
+}
+
+// AddFootnote registers a footnote (or endnote) body and returns the reference run for the text.
+// Footnotes are numbered 1, 2, ... and endnotes e1, e2, ... in document order.
+func (d *Document) AddFootnote(endnote bool, paragraphs []*Paragraph) Run {
+	number := 1
+	for _, fn := range d.Footnotes {
+		if fn.Endnote == endnote {
+			number++
+		}
+	}
+
+	id := strconv.Itoa(number)
+	if endnote {
+		id = "e" + id
+	}
+
+	d.Footnotes = append(d.Footnotes, &Footnote{
+		ID:         id,
+		Number:     number,
+		Endnote:    endnote,
+		Paragraphs: paragraphs,
+	})
+
+	return Run{Style: TextStyle{FootnoteRef: id}}
+}
+
+// Text returns the note text with paragraphs joined by newlines.
+func (f *Footnote) Text() string {
+	var texts []string
+	for _, p := range f.Paragraphs {
+		if text := strings.TrimSpace(p.Text); text != "" {
+			texts = append(texts, text)
+		}
+	}
+	return strings.Join(texts, "\n")
+}
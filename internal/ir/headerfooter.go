@@ -0,0 +1,89 @@
+package ir
+
+import "strings"
+
+// PageScope represents the pages a header, footer or master page applies to.
+type PageScope string
+
+const (
+	PageScopeBoth PageScope = "both"
+	PageScopeEven PageScope = "even"
+	PageScopeOdd  PageScope = "odd"
+)
+
+// HeaderFooter represents page header, footer or master page content.
+// It is kept apart from the body so renderers can drop it or emit it once.
+type HeaderFooter struct {
+	Scope      PageScope    `json:"scope"`
+	Paragraphs []*Paragraph `json:"paragraphs"`
+}
+
+// Text returns the content text with paragraphs joined by newlines.
+// Pictures (e.g. a logo) are written as Markdown images, so picture-only content is kept.
+func (h *HeaderFooter) Text() string {
+	var texts []string
+	for _, p := range h.Paragraphs {
+		if text := strings.TrimSpace(paragraphContent(p)); text != "" {
+			texts = append(texts, text)
+		}
+	}
+	return strings.Join(texts, "\n")
+}
+
+// paragraphContent returns the paragraph text with its inline pictures as Markdown images.
+func paragraphContent(p *Paragraph) string {
+	hasImage := false
+	for _, run := range p.Runs {
+		if run.Image != nil {
+			hasImage = true
+			break
+		}
+	}
+	if !hasImage {
+		return p.Text
+	}
+
+	var sb strings.Builder
+	for _, run := range p.Runs {
+		switch {
+		case run.Image != nil:
+			sb.WriteString(run.Image.Markdown())
+		case run.Style.Math:
+			sb.WriteString("$" + run.Text + "$")
+		default:
+			sb.WriteString(run.Text)
+		}
+	}
+	return sb.String()
+}
+
+// AddHeader adds a page header. Empty or duplicate headers (same scope and text) are ignored.
+func (d *Document) AddHeader(scope PageScope, paragraphs []*Paragraph) {
+	d.Headers = addHeaderFooter(d.Headers, scope, paragraphs)
+}
+
+// AddFooter adds a page footer. Empty or duplicate footers (same scope and text) are ignored.
+func (d *Document) AddFooter(scope PageScope, paragraphs []*Paragraph) {
+	d.Footers = addHeaderFooter(d.Footers, scope, paragraphs)
+}
+
+// AddMasterPage adds master page content. Empty or duplicate pages (same scope and text) are ignored.
+func (d *Document) AddMasterPage(scope PageScope, paragraphs []*Paragraph) {
+	d.MasterPages = addHeaderFooter(d.MasterPages, scope, paragraphs)
+}
+
+// addHeaderFooter appends new content unless it is empty or already present.
+// 머리말/꼬리말은 구역마다 반복해서 정의되므로 같은 내용은 한 번만 저장한다.
+func addHeaderFooter(list []*HeaderFooter, scope PageScope, paragraphs []*Paragraph) []*HeaderFooter {
+	hf := &HeaderFooter{Scope: scope, Paragraphs: paragraphs}
+	text := hf.Text()
+	if text == "" {
+		return list
+	}
+	for _, existing := range list {
+		if existing.Scope == scope && existing.Text() == text {
+			return list
+		}
+	}
+	return append(list, hf)
+}
@@ -1,5 +1,7 @@
 package ir
 
+import "strings"
+
 // ImageBlock represents an image reference in the document.
 type ImageBlock struct {
 	ID       string `json:"id"`                  // internal image ID
@@ -26,6 +28,23 @@ func (img *ImageBlock) SetDimensions(width, height int) {
 	img.Height = height
 }
 
+// Markdown returns the image as a Markdown image (![alt](path)).
+// The alt text falls back to the caption and the ID, and the path to the ID.
+func (img *ImageBlock) Markdown() string {
+	alt := img.Alt
+	if alt == "" {
+		alt = strings.ReplaceAll(img.Caption, "\n", " ")
+	}
+	if alt == "" {
+		alt = img.ID
+	}
+	path := img.Path
+	if path == "" {
+		path = img.ID
+	}
+	return "![" + alt + "](" + path + ")"
+}
+
 // HasData returns true if the image has raw data loaded.
 func (img *ImageBlock) HasData() bool {
 	return len(img.Data) > 0
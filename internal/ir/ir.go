@@ -4,41 +4,63 @@ package ir
 
 // Document represents the intermediate representation of an HWP document.
 type Document struct {
-	Version     string   `json:"version"`
-	Metadata    Metadata `json:"metadata"`
-	Content     []Block  `json:"content"`
-	RawMarkdown string   `json:"raw_markdown,omitempty"` // Pre-rendered markdown from external parser (e.g., Upstage)
+	Version     string          `json:"version"`
+	Metadata    Metadata        `json:"metadata"`
+	Content     []Block         `json:"content"`
+	Footnotes   []*Footnote     `json:"footnotes,omitempty"`    // footnote/endnote bodies referenced from runs
+	Comments    []*Comment      `json:"comments,omitempty"`     // memo bodies referenced from runs
+	Headers     []*HeaderFooter `json:"headers,omitempty"`      // page headers (deduplicated across sections)
+	Footers     []*HeaderFooter `json:"footers,omitempty"`      // page footers (deduplicated across sections)
+	MasterPages []*HeaderFooter `json:"master_pages,omitempty"` // master page (바탕쪽) text
+	Sections    []*Section      `json:"sections,omitempty"`     // page layout of each section
+	Fields      []*FormField    `json:"fields,omitempty"`       // click-here fields and form objects with their values
+	RawMarkdown string          `json:"raw_markdown,omitempty"` // Pre-rendered markdown from external parser (e.g., Upstage)
+
+	pendingAnchors []string // bookmarks waiting for the next block
+	page           int      // page the next block starts on
+	pageBreak      bool     // explicit page break before the next block
 }
 
 // Metadata contains document metadata.
 type Metadata struct {
-	Title       string `json:"title,omitempty"`
-	Author      string `json:"author,omitempty"`
-	Subject     string `json:"subject,omitempty"`
-	Keywords    string `json:"keywords,omitempty"`
-	Description string `json:"description,omitempty"`
-	Creator     string `json:"creator,omitempty"`
-	Created     string `json:"created,omitempty"`
-	Modified    string `json:"modified,omitempty"`
+	Title          string `json:"title,omitempty"`
+	Author         string `json:"author,omitempty"`
+	Subject        string `json:"subject,omitempty"`
+	Keywords       string `json:"keywords,omitempty"`
+	Description    string `json:"description,omitempty"`
+	Creator        string `json:"creator,omitempty"`
+	LastModifiedBy string `json:"last_modified_by,omitempty"`
+	Created        string `json:"created,omitempty"`
+	Modified       string `json:"modified,omitempty"`
+	PageCount      int    `json:"page_count,omitempty"`
 }
 
 // BlockType represents the type of content block.
 type BlockType string
 
 const (
-	BlockTypeParagraph BlockType = "paragraph"
-	BlockTypeTable     BlockType = "table"
-	BlockTypeImage     BlockType = "image"
-	BlockTypeList      BlockType = "list"
+	BlockTypeParagraph  BlockType = "paragraph"
+	BlockTypeTable      BlockType = "table"
+	BlockTypeImage      BlockType = "image"
+	BlockTypeList       BlockType = "list"
+	BlockTypeMath       BlockType = "math"
+	BlockTypeTextBox    BlockType = "textbox"
+	BlockTypeAttachment BlockType = "attachment"
 )
 
 // Block represents a content block in the document.
 type Block struct {
-	Type      BlockType   `json:"type"`
-	Paragraph *Paragraph  `json:"paragraph,omitempty"`
-	Table     *TableBlock `json:"table,omitempty"`
-	Image     *ImageBlock `json:"image,omitempty"`
-	List      *ListBlock  `json:"list,omitempty"`
+	Type       BlockType        `json:"type"`
+	Paragraph  *Paragraph       `json:"paragraph,omitempty"`
+	Table      *TableBlock      `json:"table,omitempty"`
+	Image      *ImageBlock      `json:"image,omitempty"`
+	List       *ListBlock       `json:"list,omitempty"`
+	Math       *MathBlock       `json:"math,omitempty"`
+	TextBox    *TextBoxBlock    `json:"textbox,omitempty"`
+	Attachment *AttachmentBlock `json:"attachment,omitempty"`
+	Anchors    []string         `json:"anchors,omitempty"`    // bookmark anchor IDs pointing at this block
+	Page       int              `json:"page,omitempty"`       // estimated page the block starts on (1-based)
+	PageBreak  bool             `json:"page_break,omitempty"` // block starts after an explicit page break
 }
 
 // NewDocument creates a new IR document with the current version.
@@ -49,9 +71,22 @@ func NewDocument() *Document {
 	}
 }
 
+// addBlock appends a block to the document content with the pending anchors,
+// the current page and a pending page break.
+func (d *Document) addBlock(block Block) {
+	if len(d.pendingAnchors) > 0 {
+		block.Anchors = append(block.Anchors, d.pendingAnchors...)
+		d.pendingAnchors = nil
+	}
+	block.Page = d.page
+	block.PageBreak = d.pageBreak
+	d.pageBreak = false
+	d.Content = append(d.Content, block)
+}
+
 // AddParagraph adds a paragraph block to the document.
 func (d *Document) AddParagraph(p *Paragraph) {
-	d.Content = append(d.Content, Block{
+	d.addBlock(Block{
 		Type:      BlockTypeParagraph,
 		Paragraph: p,
 	})
@@ -59,7 +94,7 @@ func (d *Document) AddParagraph(p *Paragraph) {
 
 // AddTable adds a table block to the document.
 func (d *Document) AddTable(t *TableBlock) {
-	d.Content = append(d.Content, Block{
+	d.addBlock(Block{
 		Type:  BlockTypeTable,
 		Table: t,
 	})
@@ -67,7 +102,7 @@ func (d *Document) AddTable(t *TableBlock) {
 
 // AddImage adds an image block to the document.
 func (d *Document) AddImage(img *ImageBlock) {
-	d.Content = append(d.Content, Block{
+	d.addBlock(Block{
 		Type:  BlockTypeImage,
 		Image: img,
 	})
@@ -75,7 +110,7 @@ func (d *Document) AddImage(img *ImageBlock) {
 
 // AddList adds a list block to the document.
 func (d *Document) AddList(l *ListBlock) {
-	d.Content = append(d.Content, Block{
+	d.addBlock(Block{
 		Type: BlockTypeList,
 		List: l,
 	})
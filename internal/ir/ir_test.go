@@ -2,6 +2,8 @@ package ir
 
 import (
 	"encoding/json"
+	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -228,6 +230,87 @@ func TestTable_SetHeaderRow(t *testing.T) {
 	}
 }
 
+func TestTable_DetectHeaders(t *testing.T) {
+	shaded := CellStyle{Background: "#D9D9D9"}
+
+	// Shaded first row and first column
+	table := NewTable(3, 3)
+	for j := 0; j < 3; j++ {
+		table.Cells[0][j].Style = shaded
+	}
+	table.Cells[1][0].Style.Bold = true
+	table.Cells[2][0].Style.Bold = true
+	table.DetectHeaders()
+	if !table.HasHeader || !table.HeaderColumn {
+		t.Errorf("expected header row and column, got row=%v column=%v", table.HasHeader, table.HeaderColumn)
+	}
+	if !table.Cells[2][0].Style.IsHeader || table.Cells[2][1].Style.IsHeader {
+		t.Error("expected only the first column to be marked as header cells")
+	}
+
+	// Same formatting on every row is not a header
+	table = NewTable(2, 2)
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 2; j++ {
+			table.Cells[i][j].Style = shaded
+		}
+	}
+	table.DetectHeaders()
+	if table.HasHeader || table.HeaderColumn {
+		t.Error("expected no header for a uniformly shaded table")
+	}
+
+	// A merged title cell spanning the first row still counts
+	table = NewTable(2, 2)
+	table.Cells[0][0].ColSpan = 2
+	table.Cells[0][0].Style = CellStyle{IsHeader: true}
+	table.DetectHeaders()
+	if !table.HasHeader {
+		t.Error("expected a header row for a merged header cell")
+	}
+
+	// Plain tables get no header
+	table = NewTable(2, 2)
+	table.DetectHeaders()
+	if table.HasHeader || table.HeaderColumn {
+		t.Error("expected no header without formatting")
+	}
+}
+
+func TestTable_ColumnAlignments(t *testing.T) {
+	table := NewTable(3, 3)
+	table.SetHeaderRow()
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			table.Cells[i][j].Text = "x"
+		}
+		table.Cells[i][0].Style.Alignment = AlignCenter
+		table.Cells[i][1].Style.Alignment = AlignRight
+	}
+	table.Cells[0][1].Style.Alignment = AlignCenter // header cells are ignored
+	table.Cells[2][2].Style.Alignment = AlignLeft   // mixed alignments
+
+	expected := []string{AlignCenter, AlignRight, ""}
+	got := table.ColumnAlignments()
+	for j := range expected {
+		if got[j] != expected[j] {
+			t.Errorf("column %d: expected %q, got %q", j, expected[j], got[j])
+		}
+	}
+}
+
+func TestTable_PlainText(t *testing.T) {
+	table := NewTable(2, 2)
+	table.SetCell(0, 0, "구분")
+	table.SetCell(0, 1, "내용")
+	table.SetCell(1, 1, "여러\n줄")
+
+	expected := "구분 | 내용\n여러 줄"
+	if got := table.PlainText(); got != expected {
+		t.Errorf("PlainText() = %q, want %q", got, expected)
+	}
+}
+
 func TestNewTableFromRawText(t *testing.T) {
 	raw := "A\tB\tC\nD\tE\tF"
 	table := NewTableFromRawText(raw, 2, 3)
@@ -308,6 +391,25 @@ func TestImage_SetDimensions(t *testing.T) {
 	}
 }
 
+func TestImage_Markdown(t *testing.T) {
+	img := NewImage("img1")
+	if md := img.Markdown(); md != "![img1](img1)" {
+		t.Errorf("expected ID fallback, got %q", md)
+	}
+
+	img.Path = "BinData/img1.png"
+	img.Caption = "그림 1\n지도"
+	if md := img.Markdown(); md != "![그림 1 지도](BinData/img1.png)" {
+		t.Errorf("expected caption as alt text, got %q", md)
+	}
+
+	p := NewParagraph("")
+	p.Runs = append(p.Runs, Run{Image: img})
+	if !p.HasStyledRuns() {
+		t.Error("expected an inline picture to count as a styled run")
+	}
+}
+
 func TestImage_HasData(t *testing.T) {
 	img := NewImage("img1")
 	if img.HasData() {
@@ -319,3 +421,198 @@ func TestImage_HasData(t *testing.T) {
 		t.Error("expected HasData to be true after setting data")
 	}
 }
+
+func TestDocument_AddHeader_Dedup(t *testing.T) {
+	doc := NewDocument()
+	doc.AddHeader(PageScopeBoth, []*Paragraph{NewParagraph("머리말")})
+	doc.AddHeader(PageScopeBoth, []*Paragraph{NewParagraph(" 머리말 ")})
+	doc.AddHeader(PageScopeEven, []*Paragraph{NewParagraph("머리말")})
+	doc.AddHeader(PageScopeOdd, []*Paragraph{NewParagraph("")})
+
+	if len(doc.Headers) != 2 {
+		t.Fatalf("expected 2 headers, got %d", len(doc.Headers))
+	}
+	if doc.Headers[1].Scope != PageScopeEven {
+		t.Errorf("expected even scope, got %s", doc.Headers[1].Scope)
+	}
+}
+
+// annotatedParagraph builds "기한은 {--30일--}{++60일++}로 {==한다==}{>>검토자: 근거 확인<<}".
+func annotatedParagraph(doc *Document) *Paragraph {
+	p := NewParagraph("")
+	p.AddRun("기한은 ", TextStyle{})
+	p.AddRun("30일", TextStyle{Change: ChangeDelete})
+	p.AddRun("60일", TextStyle{Change: ChangeInsert, Bold: true})
+	p.AddRun("로 ", TextStyle{})
+	ref := doc.AddComment("검토자", "", []*Paragraph{NewParagraph("근거 확인")})
+	p.AddRun("한다", TextStyle{Comment: ref.Style.CommentRef})
+	p.Runs = append(p.Runs, ref)
+	p.Text = RunsText(p.Runs)
+	return p
+}
+
+func TestRunsText_CriticMarkup(t *testing.T) {
+	doc := NewDocument()
+	p := annotatedParagraph(doc)
+
+	expected := "기한은 {--30일--}{++60일++}로 {==한다==}{>>검토자: 근거 확인<<}"
+	if p.Text != expected {
+		t.Errorf("RunsText() = %q, want %q", p.Text, expected)
+	}
+	if !p.HasAnnotations() {
+		t.Error("expected paragraph to have annotations")
+	}
+	if len(doc.Comments) != 1 || doc.Comments[0].ID != "1" {
+		t.Errorf("unexpected comments: %+v", doc.Comments)
+	}
+}
+
+func TestDocument_ResolveChanges(t *testing.T) {
+	tests := []struct {
+		accept   bool
+		text     string
+		cellText string
+	}{
+		{true, "기한은 60일로 한다", "신설"},
+		{false, "기한은 30일로 한다", "삭제"},
+	}
+
+	for _, tt := range tests {
+		doc := NewDocument()
+		doc.AddParagraph(annotatedParagraph(doc))
+		table := NewTable(1, 1)
+		table.Cells[0][0].Text = "{++신설++}{--삭제--}{>>메모<<}"
+		doc.AddTable(table)
+
+		doc.ResolveChanges(tt.accept)
+
+		p := doc.Content[0].Paragraph
+		if p.Text != tt.text || p.HasAnnotations() {
+			t.Errorf("accept=%v: paragraph = %q (%+v), want %q", tt.accept, p.Text, p.Runs, tt.text)
+		}
+		if cell := doc.Content[1].Table.Cells[0][0].Text; cell != tt.cellText {
+			t.Errorf("accept=%v: cell = %q, want %q", tt.accept, cell, tt.cellText)
+		}
+		if len(doc.Comments) != 0 {
+			t.Errorf("accept=%v: expected comments to be removed", tt.accept)
+		}
+	}
+}
+
+func TestDocument_AddAnchor(t *testing.T) {
+	if id := AnchorID(" 별표 3 (서식) "); id != "별표-3-서식" {
+		t.Errorf("AnchorID() = %q", id)
+	}
+
+	doc := NewDocument()
+	doc.AddParagraph(NewParagraph("앞"))
+	doc.AddAnchor("별표 3")
+	doc.AddAnchor("")
+	doc.AddTable(NewTable(1, 1))
+	doc.AddParagraph(NewParagraph("뒤"))
+
+	if len(doc.Content[0].Anchors) != 0 || len(doc.Content[2].Anchors) != 0 {
+		t.Errorf("anchors attached to the wrong block: %+v", doc.Content)
+	}
+	if anchors := doc.Content[1].Anchors; len(anchors) != 1 || anchors[0] != "별표-3" {
+		t.Errorf("expected anchor on the table, got %v", anchors)
+	}
+
+	// A bookmark after the last block is attached to it
+	doc.AddAnchor("끝")
+	doc.FlushAnchors()
+	if anchors := doc.Content[2].Anchors; len(anchors) != 1 || anchors[0] != "끝" {
+		t.Errorf("expected trailing anchor on the last block, got %v", anchors)
+	}
+}
+
+func TestDocument_Sections(t *testing.T) {
+	doc := NewDocument()
+	doc.AddSection(&Section{Width: 210, Height: 297, Orientation: OrientationPortrait})
+	doc.SetColumns(2, 8) // 블록이 없으면 구역을 고친다
+	doc.SetPage(1)
+	doc.AddParagraph(NewParagraph("첫 쪽"))
+	doc.SetColumns(2, 8)
+	doc.SetColumns(1, 8)
+	doc.AddPageBreak()
+	doc.SetPage(2)
+	doc.AddParagraph(NewParagraph("둘째 쪽"))
+
+	if len(doc.Sections) != 2 {
+		t.Fatalf("expected 2 sections, got %d", len(doc.Sections))
+	}
+	if s := doc.Sections[0]; s.Start != 0 || s.Columns != 2 || s.ColumnGap != 8 {
+		t.Errorf("unexpected first section: %+v", s)
+	}
+	if s := doc.Sections[1]; s.Start != 1 || s.Columns != 1 || s.ColumnGap != 0 || s.Width != 210 {
+		t.Errorf("unexpected second section: %+v", s)
+	}
+	if b := doc.Content[0]; b.Page != 1 || b.PageBreak {
+		t.Errorf("unexpected first block: page %d, break %v", b.Page, b.PageBreak)
+	}
+	if b := doc.Content[1]; b.Page != 2 || !b.PageBreak {
+		t.Errorf("unexpected second block: page %d, break %v", b.Page, b.PageBreak)
+	}
+}
+
+func TestDocument_AddField(t *testing.T) {
+	doc := NewDocument()
+	doc.AddParagraph(NewParagraph("신청서"))
+	doc.SetPage(2)
+	doc.AddField(&FormField{Name: "동의", Type: FormFieldCheckBox, Value: "true", Caption: "동의함"})
+	doc.AddField(&FormField{Name: "회신", Type: FormFieldRadio, Value: "false", Caption: "우편"})
+
+	if len(doc.Fields) != 2 {
+		t.Fatalf("expected 2 fields, got %d", len(doc.Fields))
+	}
+	if f := doc.Fields[0]; f.Block != 1 || f.Page != 2 || f.InlineText() != "☑ 동의함" {
+		t.Errorf("unexpected field: %+v (%q)", f, f.InlineText())
+	}
+	if f := doc.Fields[1]; f.Checked() || f.InlineText() != "○ 우편" {
+		t.Errorf("unexpected radio button: %+v (%q)", f, f.InlineText())
+	}
+	if f := (&FormField{Type: FormFieldComboBox, Value: "서울"}); f.InlineText() != "서울" {
+		t.Errorf("expected combo box value as inline text, got %q", f.InlineText())
+	}
+}
+
+func TestAttachment_Save(t *testing.T) {
+	a := NewAttachment("BIN0002", "xlsx")
+	a.Data = []byte("PK")
+	if a.Name != "BIN0002.xlsx" || !a.HasData() {
+		t.Fatalf("unexpected attachment: %+v", a)
+	}
+
+	dir := filepath.Join(t.TempDir(), "attachments")
+	if err := a.Save(dir); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	if a.Path != filepath.Join(dir, "BIN0002.xlsx") {
+		t.Errorf("unexpected path: %s", a.Path)
+	}
+	if data, err := os.ReadFile(a.Path); err != nil || string(data) != "PK" {
+		t.Errorf("unexpected saved data: %q, %v", data, err)
+	}
+
+	// Objects with the same original file name are kept apart by their IDs
+	for _, id := range []string{"BIN0003", "BIN0004"} {
+		named := NewAttachment(id, "xlsx")
+		named.Name = "예산.xlsx"
+		named.Data = []byte(id)
+		if err := named.Save(dir); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+		if named.Path != filepath.Join(dir, id+"_예산.xlsx") {
+			t.Errorf("unexpected path: %s", named.Path)
+		}
+		if data, err := os.ReadFile(named.Path); err != nil || string(data) != id {
+			t.Errorf("unexpected saved data: %q, %v", data, err)
+		}
+	}
+
+	doc := NewDocument()
+	doc.AddAttachment(a)
+	if doc.Content[0].Type != BlockTypeAttachment || doc.Content[0].Attachment != a {
+		t.Errorf("expected attachment block, got %+v", doc.Content[0])
+	}
+}
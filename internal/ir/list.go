@@ -5,13 +5,42 @@ type ListBlock struct {
 	Ordered bool       `json:"ordered"` // true = numbered list, false = bullet list
 	Items   []ListItem `json:"items"`
 	Start   int        `json:"start,omitempty"` // starting number for ordered lists
+	Level   int        `json:"level,omitempty"` // nesting level of this list (0 = top level)
+
+	// Marker style of the source document, kept as metadata; Markdown only has "1." and "-"
+	Format NumberFormat `json:"format,omitempty"` // number format of ordered lists
+	Marker string       `json:"marker,omitempty"` // number format string (e.g. "^1.", "(^2)") or bullet character
+
+	// Sublist of another type or numbering that follows this one under the same item
+	Next *ListBlock `json:"next,omitempty"`
 }
 
+// NumberFormat is the number format of an ordered list (e.g. 1, 가, ①, i).
+type NumberFormat string
+
+// Number formats of ordered lists.
+const (
+	NumberDigit                 NumberFormat = "digit"                   // 1, 2, 3
+	NumberCircledDigit          NumberFormat = "circled_digit"           // ①, ②, ③
+	NumberUpperRoman            NumberFormat = "upper_roman"             // I, II, III
+	NumberLowerRoman            NumberFormat = "lower_roman"             // i, ii, iii
+	NumberUpperLatin            NumberFormat = "upper_latin"             // A, B, C
+	NumberLowerLatin            NumberFormat = "lower_latin"             // a, b, c
+	NumberHangulSyllable        NumberFormat = "hangul_syllable"         // 가, 나, 다
+	NumberCircledHangulSyllable NumberFormat = "circled_hangul_syllable" // ㉮, ㉯, ㉰
+	NumberHangulJamo            NumberFormat = "hangul_jamo"             // ㄱ, ㄴ, ㄷ
+	NumberCircledHangulJamo     NumberFormat = "circled_hangul_jamo"     // ㉠, ㉡, ㉢
+	NumberIdeograph             NumberFormat = "ideograph"               // 一, 二, 三
+)
+
 // ListItem represents a single item in a list.
 type ListItem struct {
 	Text     string     `json:"text"`
+	Runs     []Run      `json:"runs,omitempty"`     // styled text runs
 	Level    int        `json:"level,omitempty"`    // nesting level (0 = top level)
+	Marker   string     `json:"marker,omitempty"`   // marker shown by the word processor (e.g. "가.", "①")
 	Children []ListItem `json:"children,omitempty"` // nested items
+	Sublist  *ListBlock `json:"sublist,omitempty"`  // nested list with its own type and numbering
 }
 
 // NewList creates a new list block.
@@ -49,6 +78,11 @@ func (l *ListBlock) AddItemWithLevel(text string, level int) {
 	})
 }
 
+// HasStyledRuns returns true if any run of the item carries character styling or an inline picture.
+func (i *ListItem) HasStyledRuns() bool {
+	return hasStyledRuns(i.Runs)
+}
+
 // IsEmpty returns true if the list has no items.
 func (l *ListBlock) IsEmpty() bool {
 	return len(l.Items) == 0
@@ -0,0 +1,24 @@
+package ir
+
+// MathBlock represents a display equation rendered on its own line ($$...$$).
+// Inline equations are runs with Style.Math set and LaTeX as their text.
+type MathBlock struct {
+	TeX    string `json:"tex"`              // LaTeX expression without delimiters
+	Script string `json:"script,omitempty"` // original equation script (HWP 수식 문법)
+}
+
+// NewMath creates a new display equation.
+func NewMath(tex, script string) *MathBlock {
+	return &MathBlock{
+		TeX:    tex,
+		Script: script,
+	}
+}
+
+// AddMath adds a display equation block to the document.
+func (d *Document) AddMath(m *MathBlock) {
+	d.addBlock(Block{
+		Type: BlockTypeMath,
+		Math: m,
+	})
+}
@@ -0,0 +1,80 @@
+package ir
+
+// Orientation is the paper orientation of a section.
+type Orientation string
+
+const (
+	OrientationPortrait  Orientation = "portrait"
+	OrientationLandscape Orientation = "landscape"
+)
+
+// Section describes the page layout of a document section (구역).
+// A section applies from Content[Start] until the next section; a change of columns
+// within a section starts a new entry with the same page settings. Lengths are in millimeters.
+type Section struct {
+	Start       int         `json:"start"` // index of the first block in Document.Content
+	Width       float64     `json:"width_mm"`
+	Height      float64     `json:"height_mm"`
+	Orientation Orientation `json:"orientation"`
+	Margins     Margins     `json:"margins_mm"`
+	Columns     int         `json:"columns"`
+	ColumnGap   float64     `json:"column_gap_mm,omitempty"`
+}
+
+// Margins are the page margins of a section in millimeters.
+type Margins struct {
+	Top    float64 `json:"top"`
+	Bottom float64 `json:"bottom"`
+	Left   float64 `json:"left"`
+	Right  float64 `json:"right"`
+	Header float64 `json:"header,omitempty"`
+	Footer float64 `json:"footer,omitempty"`
+}
+
+// AddSection starts a new section at the next block.
+func (d *Document) AddSection(s *Section) {
+	if s.Columns < 1 {
+		s.Columns = 1
+	}
+	s.Start = len(d.Content)
+	d.Sections = append(d.Sections, s)
+}
+
+// SetColumns changes the columns from the next block on (단 정의).
+// If no block was added since the current section started, the section itself is updated;
+// otherwise a section with the same page settings and the new columns is started.
+// The gap is ignored for a single column.
+func (d *Document) SetColumns(columns int, gap float64) {
+	if columns <= 1 {
+		columns, gap = 1, 0
+	}
+	n := len(d.Sections)
+	if n == 0 {
+		d.AddSection(&Section{Columns: columns, ColumnGap: gap})
+		return
+	}
+
+	last := d.Sections[n-1]
+	if last.Start == len(d.Content) {
+		last.Columns = columns
+		last.ColumnGap = gap
+		return
+	}
+	if last.Columns == columns && last.ColumnGap == gap {
+		return
+	}
+	next := *last
+	next.Columns = columns
+	next.ColumnGap = gap
+	d.AddSection(&next)
+}
+
+// SetPage sets the (estimated) page number that the following blocks start on.
+func (d *Document) SetPage(page int) {
+	d.page = page
+}
+
+// AddPageBreak marks the next block added to the document as starting after an explicit page break.
+func (d *Document) AddPageBreak() {
+	d.pageBreak = true
+}
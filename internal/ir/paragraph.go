@@ -9,8 +9,9 @@ type Paragraph struct {
 
 // Run represents a styled text run within a paragraph.
 type Run struct {
-	Text  string    `json:"text"`
-	Style TextStyle `json:"style,omitempty"`
+	Text  string      `json:"text"`
+	Style TextStyle   `json:"style,omitempty"`
+	Image *ImageBlock `json:"image,omitempty"` // picture placed in the text (run text is empty)
 }
 
 // ParagraphStyle contains paragraph-level styling hints.
@@ -23,14 +24,21 @@ type ParagraphStyle struct {
 
 // TextStyle contains character-level styling hints.
 type TextStyle struct {
-	Bold          bool   `json:"bold,omitempty"`
-	Italic        bool   `json:"italic,omitempty"`
-	Underline     bool   `json:"underline,omitempty"`
-	Strikethrough bool   `json:"strikethrough,omitempty"`
-	Superscript   bool   `json:"superscript,omitempty"`
-	Subscript     bool   `json:"subscript,omitempty"`
-	Code          bool   `json:"code,omitempty"`
-	Link          string `json:"link,omitempty"` // hyperlink URL
+	Bold          bool       `json:"bold,omitempty"`
+	Italic        bool       `json:"italic,omitempty"`
+	Underline     bool       `json:"underline,omitempty"`
+	Strikethrough bool       `json:"strikethrough,omitempty"`
+	Superscript   bool       `json:"superscript,omitempty"`
+	Subscript     bool       `json:"subscript,omitempty"`
+	Code          bool       `json:"code,omitempty"`
+	Link          string     `json:"link,omitempty"`          // hyperlink URL
+	Highlight     string     `json:"highlight,omitempty"`     // background/shade colour hint (#RRGGBB)
+	FootnoteRef   string     `json:"footnote_ref,omitempty"`  // ID of the referenced footnote (run text is empty)
+	Math          bool       `json:"math,omitempty"`          // inline equation (run text is LaTeX)
+	Change        ChangeType `json:"change,omitempty"`        // tracked change (insert/delete)
+	ChangeAuthor  string     `json:"change_author,omitempty"` // author of the tracked change
+	Comment       string     `json:"comment,omitempty"`       // ID of the comment whose range covers the run
+	CommentRef    string     `json:"comment_ref,omitempty"`   // ID of the comment placed here (run text is the comment label)
 }
 
 // NewParagraph creates a new paragraph with the given text.
@@ -60,6 +68,21 @@ func (p *Paragraph) SetHeading(level int) {
 	p.Style.HeadingLevel = level
 }
 
+// HasStyledRuns returns true if any run carries character styling or an inline picture.
+func (p *Paragraph) HasStyledRuns() bool {
+	return hasStyledRuns(p.Runs)
+}
+
+// hasStyledRuns returns true if any run has a non-empty text style.
+func hasStyledRuns(runs []Run) bool {
+	for _, run := range runs {
+		if run.Style != (TextStyle{}) || run.Image != nil {
+			return true
+		}
+	}
+	return false
+}
+
 // IsEmpty returns true if the paragraph has no text content.
 func (p *Paragraph) IsEmpty() bool {
 	return p.Text == "" && len(p.Runs) == 0
@@ -1,28 +1,50 @@
 package ir
 
+import "strings"
+
 // TableBlock represents a table region in the document.
 type TableBlock struct {
-	Rows      int      `json:"rows"`
-	Cols      int      `json:"cols"`
-	Cells     [][]Cell `json:"cells,omitempty"`
-	RawText   string   `json:"raw_text,omitempty"`   // fallback: tab/newline separated text
-	Caption   string   `json:"caption,omitempty"`    // table caption if any
-	HasHeader bool     `json:"has_header,omitempty"` // first row is header
+	Rows         int         `json:"rows"`
+	Cols         int         `json:"cols"`
+	Cells        [][]Cell    `json:"cells,omitempty"`
+	RawText      string      `json:"raw_text,omitempty"`      // fallback: tab/newline separated text
+	Caption      string      `json:"caption,omitempty"`       // table caption if any
+	HasHeader    bool        `json:"has_header,omitempty"`    // first row is header
+	HeaderColumn bool        `json:"header_column,omitempty"` // first column holds row headings
+	Chart        string      `json:"chart,omitempty"`         // ID of the chart object the table was decoded from
+	ChartImage   *ImageBlock `json:"chart_image,omitempty"`   // picture of the chart object, when images are extracted
 }
 
 // Cell represents a single cell in a table.
+// Tables nested in the cell are kept in Tables; Text also contains their flattened text
+// so renderers that only handle plain cells still show the content.
 type Cell struct {
-	Text    string    `json:"text"`
-	RowSpan int       `json:"row_span,omitempty"` // number of rows this cell spans
-	ColSpan int       `json:"col_span,omitempty"` // number of columns this cell spans
-	Style   CellStyle `json:"style,omitempty"`
+	Text    string        `json:"text"`
+	RowSpan int           `json:"row_span,omitempty"` // number of rows this cell spans
+	ColSpan int           `json:"col_span,omitempty"` // number of columns this cell spans
+	Style   CellStyle     `json:"style,omitempty"`
+	Tables  []*TableBlock `json:"tables,omitempty"` // nested tables
+	Images  []*ImageBlock `json:"images,omitempty"` // pictures in the cell
 }
 
 // CellStyle contains cell-level styling hints.
 type CellStyle struct {
-	Bold      bool   `json:"bold,omitempty"`
-	Alignment string `json:"alignment,omitempty"` // left, center, right
-	IsHeader  bool   `json:"is_header,omitempty"`
+	Bold       bool   `json:"bold,omitempty"`       // all text in the cell is bold
+	Alignment  string `json:"alignment,omitempty"`  // left, center, right
+	IsHeader   bool   `json:"is_header,omitempty"`  // header cell (set by the document or by DetectHeaders)
+	Background string `json:"background,omitempty"` // fill colour as "#RRGGBB", empty if not shaded
+}
+
+// Cell alignments
+const (
+	AlignLeft   = "left"
+	AlignCenter = "center"
+	AlignRight  = "right"
+)
+
+// emphasis returns the formatting that sets a header cell apart, or the zero value if none.
+func (s CellStyle) emphasis() CellStyle {
+	return CellStyle{Bold: s.Bold, IsHeader: s.IsHeader, Background: s.Background}
 }
 
 // NewTable creates a new table with the specified dimensions.
@@ -77,3 +99,142 @@ func (t *TableBlock) SetHeaderRow() {
 		}
 	}
 }
+
+// DetectHeaders marks the header row and header column from the cell formatting.
+// The first row is a header if all of its cells are marked as headers, shaded or bold,
+// and the second row is formatted differently; the first column likewise.
+// Tables without such formatting get no header, since guessing one is often wrong.
+func (t *TableBlock) DetectHeaders() {
+	if t.Rows < 2 || t.Cells == nil {
+		return
+	}
+	covered := t.coveredCells()
+
+	// rowEmphasis returns the common emphasis of the cells of a row, or the zero value if they differ
+	rowEmphasis := func(row, fromCol int) (CellStyle, bool) {
+		var style CellStyle
+		found := false
+		for j := fromCol; j < t.Cols; j++ {
+			if covered[row][j] {
+				continue
+			}
+			e := t.Cells[row][j].Style.emphasis()
+			if found && e != style {
+				return CellStyle{}, false
+			}
+			style, found = e, true
+		}
+		return style, found
+	}
+
+	if first, ok := rowEmphasis(0, 0); ok && first != (CellStyle{}) {
+		if second, ok := rowEmphasis(1, 0); !ok || second != first {
+			t.SetHeaderRow()
+		}
+	}
+
+	// colEmphasis returns the common emphasis of the body cells of a column
+	bodyStart := 0
+	if t.HasHeader {
+		bodyStart = 1
+	}
+	colEmphasis := func(col int) (CellStyle, bool) {
+		var style CellStyle
+		found := false
+		for i := bodyStart; i < t.Rows; i++ {
+			if covered[i][col] {
+				continue
+			}
+			e := t.Cells[i][col].Style.emphasis()
+			if found && e != style {
+				return CellStyle{}, false
+			}
+			style, found = e, true
+		}
+		return style, found
+	}
+
+	if t.Cols < 2 || t.Rows-bodyStart < 2 {
+		return
+	}
+	if first, ok := colEmphasis(0); ok && first != (CellStyle{}) {
+		if second, ok := colEmphasis(1); !ok || second != first {
+			t.HeaderColumn = true
+			for i := bodyStart; i < t.Rows; i++ {
+				t.Cells[i][0].Style.IsHeader = true
+			}
+		}
+	}
+}
+
+// ColumnAlignments returns the alignment of each column: the alignment shared by all non-empty
+// body cells of the column, or "" if they differ or are not set.
+func (t *TableBlock) ColumnAlignments() []string {
+	alignments := make([]string, t.Cols)
+	if t.Cells == nil {
+		return alignments
+	}
+	covered := t.coveredCells()
+
+	bodyStart := 0
+	if t.HasHeader && t.Rows > 1 {
+		bodyStart = 1
+	}
+	for j := 0; j < t.Cols; j++ {
+		align, found := "", false
+		for i := bodyStart; i < t.Rows; i++ {
+			cell := t.Cells[i][j]
+			if covered[i][j] || cell.ColSpan > 1 || strings.TrimSpace(cell.Text) == "" {
+				continue
+			}
+			if found && cell.Style.Alignment != align {
+				align = ""
+				break
+			}
+			align, found = cell.Style.Alignment, true
+		}
+		alignments[j] = align
+	}
+	return alignments
+}
+
+// coveredCells reports the positions covered by a cell spanning from another row or column.
+func (t *TableBlock) coveredCells() [][]bool {
+	covered := make([][]bool, len(t.Cells))
+	for i := range covered {
+		covered[i] = make([]bool, t.Cols)
+	}
+	for i, row := range t.Cells {
+		for j, cell := range row {
+			if j >= t.Cols || covered[i][j] {
+				continue
+			}
+			for r := i; r < i+max(cell.RowSpan, 1) && r < len(t.Cells); r++ {
+				for c := j; c < j+max(cell.ColSpan, 1) && c < t.Cols; c++ {
+					if r != i || c != j {
+						covered[r][c] = true
+					}
+				}
+			}
+		}
+	}
+	return covered
+}
+
+// PlainText flattens the table to text: one line per row with non-empty cells joined by " | ".
+// Line breaks inside cells become spaces.
+func (t *TableBlock) PlainText() string {
+	var sb strings.Builder
+	for _, row := range t.Cells {
+		var texts []string
+		for _, cell := range row {
+			if text := strings.TrimSpace(cell.Text); text != "" {
+				texts = append(texts, strings.ReplaceAll(text, "\n", " "))
+			}
+		}
+		if len(texts) > 0 {
+			sb.WriteString(strings.Join(texts, " | ") + "\n")
+		}
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
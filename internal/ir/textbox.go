@@ -0,0 +1,36 @@
+package ir
+
+// TextBoxBlock represents the text of a text box or drawing object (글상자).
+// Text boxes hold call-outs and summaries beside the body, so renderers treat them
+// as an aside (e.g. a Markdown blockquote).
+type TextBoxBlock struct {
+	Paragraphs []*Paragraph `json:"paragraphs"`
+}
+
+// NewTextBox creates a new text box with the given paragraphs.
+func NewTextBox(paragraphs []*Paragraph) *TextBoxBlock {
+	return &TextBoxBlock{
+		Paragraphs: paragraphs,
+	}
+}
+
+// IsEmpty returns true if the text box has no non-empty paragraph.
+func (t *TextBoxBlock) IsEmpty() bool {
+	for _, p := range t.Paragraphs {
+		if !p.IsEmpty() {
+			return false
+		}
+	}
+	return true
+}
+
+// AddTextBox adds a text box block to the document. Empty text boxes are ignored.
+func (d *Document) AddTextBox(t *TextBoxBlock) {
+	if t == nil || t.IsEmpty() {
+		return
+	}
+	d.addBlock(Block{
+		Type:    BlockTypeTextBox,
+		TextBox: t,
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"unicode"
 
 	"github.com/roboco-io/hwp2md/internal/ir"
 )
@@ -25,22 +26,48 @@ const SystemPrompt = `당신은 한글(HWP) 문서를 깔끔한 Markdown으로 
 - GFM 테이블 문법 사용
 - 첫 행이 헤더면 구분선 추가
 - 셀 내용은 줄바꿈을 공백으로 대체
+- chart가 있는 표는 차트의 계열 값이므로 숫자를 그대로 유지
 
 ### 이미지 (image)
 - ![대체텍스트](경로) 형식 사용
 - 대체텍스트가 없으면 이미지 ID 사용
 
+### 첨부 파일 (attachment)
+- 문서에 삽입된 스프레드시트 등 OLE 개체
+- [첨부: 이름](경로) 형식의 링크로 작성
+
 ### 목록 (list)
 - 순서 있는 목록: 1. 2. 3.
 - 순서 없는 목록: -
 - 중첩 목록은 2칸 들여쓰기
 
+### 각주 (footnote)
+- 본문의 [^1] 표시는 그대로 유지
+- [각주] 목록은 문서 끝에 [^1]: 내용 형식의 GFM 각주로 작성
+
+### 수식 (equation)
+- $...$ (본문 속 수식)과 [수식] $$...$$ (별도 줄 수식)의 LaTeX는 수정하지 말고 그대로 유지
+- 별도 줄 수식은 $$ 블록으로 작성
+
+### 글상자 (text box)
+- [글상자] 다음 줄들은 본문 옆에 놓인 요약/설명 상자의 내용
+- 인용문(> )으로 작성
+
+### 책갈피와 상호 참조 (anchor)
+- 블록의 anchors 또는 [책갈피: id] 표시는 블록 앞에 <a id="id"></a>로 작성
+- [텍스트](#id) 형식의 문서 내 링크는 그대로 유지
+
+### 변경 내용과 메모 (CriticMarkup)
+- {++삽입++}, {--삭제--}, {==메모를 붙인 구간==}, {>>메모<<} 표기는 검토 내용이므로 수정하지 말고 그대로 유지
+
 ### 텍스트 스타일
 - 굵게: **텍스트**
 - 기울임: *텍스트*
 - 취소선: ~~텍스트~~
 - 코드: ` + "`텍스트`" + `
 - 링크: [텍스트](URL)
+- 위/아래 첨자: <sup>텍스트</sup>, <sub>텍스트</sub>
+- 음영 강조(<mark>텍스트</mark>): 원문에서 강조한 부분이므로 **텍스트**로 변환
 
 ## 출력 형식
 - Markdown만 출력 (설명이나 코드블록 없이)
@@ -80,6 +107,9 @@ func BuildCompactPrompt(doc *ir.Document) string {
 		if i > 0 {
 			sb.WriteString("\n")
 		}
+		if len(block.Anchors) > 0 {
+			sb.WriteString("[책갈피: " + strings.Join(block.Anchors, ", ") + "]\n")
+		}
 
 		switch block.Type {
 		case ir.BlockTypeParagraph:
@@ -98,6 +128,29 @@ func BuildCompactPrompt(doc *ir.Document) string {
 			if block.List != nil {
 				writeListPrompt(&sb, block.List)
 			}
+		case ir.BlockTypeMath:
+			if block.Math != nil {
+				sb.WriteString("[수식] $$" + block.Math.TeX + "$$\n")
+			}
+		case ir.BlockTypeTextBox:
+			if block.TextBox != nil {
+				sb.WriteString("[글상자]\n")
+				for _, p := range block.TextBox.Paragraphs {
+					writeParagraphPrompt(&sb, p)
+				}
+			}
+		case ir.BlockTypeAttachment:
+			if block.Attachment != nil {
+				sb.WriteString(fmt.Sprintf("[첨부: %s, 경로: %s]\n", block.Attachment.Name, block.Attachment.Path))
+			}
+		}
+	}
+
+	// Footnotes
+	if len(doc.Footnotes) > 0 {
+		sb.WriteString("\n[각주]\n")
+		for _, fn := range doc.Footnotes {
+			sb.WriteString(fmt.Sprintf("[^%s]: %s\n", fn.ID, strings.ReplaceAll(fn.Text(), "\n", " ")))
 		}
 	}
 
@@ -111,11 +164,88 @@ func writeParagraphPrompt(sb *strings.Builder, p *ir.Paragraph) {
 	if p.Style.IsQuote {
 		sb.WriteString("[인용] ")
 	}
-	sb.WriteString(p.Text)
+	if p.HasStyledRuns() {
+		writeRunsPrompt(sb, p.Runs)
+	} else {
+		sb.WriteString(p.Text)
+	}
 	sb.WriteString("\n")
 }
 
+// writeRunsPrompt writes paragraph runs with inline emphasis markers.
+// 음영은 원문에서 강조한 부분이므로 <mark>로 표시해 LLM에 힌트를 준다.
+// 변경 내용과 메모는 CriticMarkup으로 쓴다.
+func writeRunsPrompt(sb *strings.Builder, runs []ir.Run) {
+	comment := ""
+	for _, run := range runs {
+		if run.Style.Comment != comment {
+			if comment != "" {
+				sb.WriteString("==}")
+			}
+			if run.Style.Comment != "" {
+				sb.WriteString("{==")
+			}
+			comment = run.Style.Comment
+		}
+
+		if run.Image != nil {
+			sb.WriteString(imagePrompt(run.Image))
+			continue
+		}
+		if run.Style.CommentRef != "" {
+			sb.WriteString("{>>" + run.Text + "<<}")
+			continue
+		}
+		if run.Style.FootnoteRef != "" {
+			sb.WriteString("[^" + run.Style.FootnoteRef + "]")
+			continue
+		}
+		if run.Style.Math {
+			sb.WriteString(ir.CriticChange(run.Style.Change, "$"+strings.TrimSpace(run.Text)+"$"))
+			continue
+		}
+
+		text := strings.TrimSpace(run.Text)
+		if text == "" {
+			sb.WriteString(ir.CriticChange(run.Style.Change, run.Text))
+			continue
+		}
+		// 강조 표시 안쪽에 공백이 들어가지 않도록 앞뒤 공백은 바깥에 쓴다
+		trimmedLeft := strings.TrimLeftFunc(run.Text, unicode.IsSpace)
+		sb.WriteString(run.Text[:len(run.Text)-len(trimmedLeft)])
+
+		if run.Style.Superscript {
+			text = "<sup>" + text + "</sup>"
+		} else if run.Style.Subscript {
+			text = "<sub>" + text + "</sub>"
+		}
+		if run.Style.Strikethrough {
+			text = "~~" + text + "~~"
+		}
+		if run.Style.Italic {
+			text = "*" + text + "*"
+		}
+		if run.Style.Bold {
+			text = "**" + text + "**"
+		}
+		if run.Style.Highlight != "" {
+			text = "<mark>" + text + "</mark>"
+		}
+		if run.Style.Link != "" {
+			text = "[" + text + "](" + run.Style.Link + ")"
+		}
+		sb.WriteString(ir.CriticChange(run.Style.Change, text))
+		sb.WriteString(trimmedLeft[len(strings.TrimRightFunc(trimmedLeft, unicode.IsSpace)):])
+	}
+	if comment != "" {
+		sb.WriteString("==}")
+	}
+}
+
 func writeTablePrompt(sb *strings.Builder, t *ir.TableBlock) {
+	if t.Chart != "" {
+		sb.WriteString("[차트: " + t.Chart + "]\n")
+	}
 	sb.WriteString("[표]\n")
 	for i, row := range t.Cells {
 		if i == 0 && t.HasHeader {
@@ -132,11 +262,16 @@ func writeTablePrompt(sb *strings.Builder, t *ir.TableBlock) {
 }
 
 func writeImagePrompt(sb *strings.Builder, img *ir.ImageBlock) {
+	sb.WriteString(imagePrompt(img) + "\n")
+}
+
+// imagePrompt returns the image marker shown to the model.
+func imagePrompt(img *ir.ImageBlock) string {
 	alt := img.Alt
 	if alt == "" {
 		alt = img.ID
 	}
-	sb.WriteString(fmt.Sprintf("[이미지: %s, 경로: %s]\n", alt, img.Path))
+	return fmt.Sprintf("[이미지: %s, 경로: %s]", alt, img.Path)
 }
 
 func writeListPrompt(sb *strings.Builder, l *ir.ListBlock) {
@@ -145,7 +280,31 @@ func writeListPrompt(sb *strings.Builder, l *ir.ListBlock) {
 		listType = "순서있는"
 	}
 	sb.WriteString(fmt.Sprintf("[%s 목록]\n", listType))
-	for _, item := range l.Items {
-		sb.WriteString(fmt.Sprintf("- %s\n", item.Text))
+	writeListItemsPrompt(sb, l.Items, l.Ordered, l.Start, "")
+}
+
+func writeListItemsPrompt(sb *strings.Builder, items []ir.ListItem, ordered bool, start int, indent string) {
+	if start < 1 {
+		start = 1
+	}
+	for i, item := range items {
+		prefix := "- "
+		if ordered {
+			prefix = fmt.Sprintf("%d. ", start+i)
+		}
+		sb.WriteString(indent + prefix)
+		if item.HasStyledRuns() {
+			writeRunsPrompt(sb, item.Runs)
+		} else {
+			sb.WriteString(item.Text)
+		}
+		sb.WriteString("\n")
+
+		if len(item.Children) > 0 {
+			writeListItemsPrompt(sb, item.Children, ordered, 1, indent+"  ")
+		}
+		for sub := item.Sublist; sub != nil; sub = sub.Next {
+			writeListItemsPrompt(sb, sub.Items, sub.Ordered, sub.Start, indent+"  ")
+		}
 	}
 }
@@ -133,6 +133,38 @@ func TestBuildCompactPrompt_WithOrderedList(t *testing.T) {
 	}
 }
 
+func TestBuildCompactPrompt_WithRuns(t *testing.T) {
+	doc := ir.NewDocument()
+	p := ir.NewParagraph("제1조 목적 위반 시 처벌")
+	p.AddRun("제1조 ", ir.TextStyle{Bold: true})
+	p.AddRun("목적 ", ir.TextStyle{})
+	p.AddRun("위반 시 처벌", ir.TextStyle{Highlight: "#FFFF00"})
+	doc.AddParagraph(p)
+
+	prompt := BuildCompactPrompt(doc)
+
+	if !strings.Contains(prompt, "**제1조** 목적 <mark>위반 시 처벌</mark>") {
+		t.Errorf("expected prompt to contain styled runs, got: %s", prompt)
+	}
+}
+
+func TestBuildCompactPrompt_WithFootnotes(t *testing.T) {
+	doc := ir.NewDocument()
+	p := ir.NewParagraph("본문")
+	p.AddRun("본문", ir.TextStyle{})
+	p.Runs = append(p.Runs, doc.AddFootnote(false, []*ir.Paragraph{ir.NewParagraph("각주 내용")}))
+	doc.AddParagraph(p)
+
+	prompt := BuildCompactPrompt(doc)
+
+	if !strings.Contains(prompt, "본문[^1]") {
+		t.Errorf("expected footnote reference, got: %s", prompt)
+	}
+	if !strings.Contains(prompt, "[각주]\n[^1]: 각주 내용") {
+		t.Errorf("expected footnote section, got: %s", prompt)
+	}
+}
+
 func TestSystemPrompt(t *testing.T) {
 	if SystemPrompt == "" {
 		t.Error("SystemPrompt should not be empty")
@@ -0,0 +1,339 @@
+// Package ole unwraps the payload of embedded OLE objects (개체 연결 및 삽입).
+//
+// Hancom Office stores an embedded object as an OLE compound file in BinData
+// (in HWP 5.x prefixed by its 4-byte length). Depending on the source application,
+// the original file is a stream of that compound file ("Package" for Office Open XML
+// files, "\x01Ole10Native" for packaged files) or the compound file itself (.xls, .doc).
+// Charts keep their data as OOXML chart XML in the "OOXMLChartContents" stream, and
+// "\x02OlePres000" holds the picture shown in the document.
+package ole
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/richardlehane/mscfb"
+	"github.com/roboco-io/hwp2md/internal/ir"
+)
+
+// 복합 파일 스트림 이름 (mscfb는 \x01, \x02 같은 앞쪽 제어 문자를 뺀 이름을 준다)
+const (
+	streamPackage      = "Package"            // Office Open XML 파일 (xlsx, docx, pptx)
+	streamOle10Native  = "Ole10Native"        // \x01Ole10Native: 패키지 개체 (임의 파일)
+	streamPresentation = "OlePres000"         // \x02OlePres000: 문서에 표시되는 그림 (미리 보기)
+	streamChart        = "OOXMLChartContents" // 차트 XML
+	streamWorkbook     = "Workbook"           // Excel 97-2003
+	streamBook         = "Book"               // Excel 5.0/95
+	streamWordDoc      = "WordDocument"       // Word 97-2003
+	streamPowerPoint   = "PowerPoint Document"
+)
+
+// 미리 보기 그림의 표준 클립보드 형식
+const (
+	cfMetafilePict = 3  // Windows 메타파일
+	cfDIB          = 8  // 장치 독립 비트맵
+	cfEnhMetafile  = 14 // 확장 메타파일
+)
+
+// cfbSignature is the magic number of an OLE compound file.
+var cfbSignature = []byte{0xD0, 0xCF, 0x11, 0xE0, 0xA1, 0xB1, 0x1A, 0xE1}
+
+// Object is the payload of an embedded OLE object.
+type Object struct {
+	Format string // file extension of Data: xlsx, xls, docx, doc, pptx, ppt, ole, ...
+	Data   []byte // the embedded file
+	Name   string // original file name, if the object records one
+	Chart  []byte // chart XML (c:chartSpace) if the object is a chart
+
+	Preview       []byte // picture shown in the document, if the object keeps one
+	PreviewFormat string // file extension of Preview: wmf, emf or bmp
+}
+
+// Parse unwraps an embedded OLE object from its BinData payload.
+func Parse(data []byte) (*Object, error) {
+	// HWP 5.x는 복합 파일 앞에 4바이트 길이를 붙인다
+	if !bytes.HasPrefix(data, cfbSignature) && len(data) > 4 && bytes.HasPrefix(data[4:], cfbSignature) {
+		data = data[4:]
+	}
+	if !bytes.HasPrefix(data, cfbSignature) {
+		return nil, fmt.Errorf("not an OLE compound file")
+	}
+
+	doc, err := mscfb.New(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read OLE compound file: %w", err)
+	}
+
+	streams := make(map[string][]byte)
+	for _, entry := range doc.File {
+		// 최상위 스트림만 사용
+		if len(entry.Path) > 0 || entry.Size == 0 {
+			continue
+		}
+		if content, err := io.ReadAll(entry); err == nil {
+			streams[entry.Name] = content
+		}
+	}
+
+	obj := &Object{Format: "ole", Data: data}
+	switch {
+	case streams[streamPackage] != nil:
+		obj.Data = streams[streamPackage]
+		obj.Format = packageFormat(obj.Data)
+	case streams[streamOle10Native] != nil:
+		if name, content, ok := parseOle10Native(streams[streamOle10Native]); ok {
+			// 이름은 ANSI (CP949 등)로 저장되므로 UTF-8로 읽히는 경우만 쓴다
+			if utf8.ValidString(name) {
+				obj.Name = name
+			}
+			obj.Data = content
+			obj.Format = strings.TrimPrefix(strings.ToLower(filepath.Ext(name)), ".")
+		}
+	case streams[streamWorkbook] != nil || streams[streamBook] != nil:
+		obj.Format = "xls"
+	case streams[streamWordDoc] != nil:
+		obj.Format = "doc"
+	case streams[streamPowerPoint] != nil:
+		obj.Format = "ppt"
+	}
+	if obj.Format == "" {
+		obj.Format = "bin"
+	}
+
+	if chart := streams[streamChart]; chart != nil {
+		obj.Chart = chartXML(chart)
+	}
+	if pres := streams[streamPresentation]; pres != nil {
+		obj.Preview, obj.PreviewFormat, _ = parsePresentation(pres)
+	}
+
+	return obj, nil
+}
+
+// Attachment returns the embedded file as an attachment block with the given object ID.
+// The original file name is kept when the object records one.
+func (o *Object) Attachment(id string) *ir.AttachmentBlock {
+	attachment := ir.NewAttachment(id, o.Format)
+	if o.Name != "" {
+		attachment.Name = o.Name
+	}
+	attachment.Data = o.Data
+	return attachment
+}
+
+// PreviewImage returns the preview picture as an image block with the given object ID,
+// or nil if the object keeps none.
+func (o *Object) PreviewImage(id string) *ir.ImageBlock {
+	if len(o.Preview) == 0 {
+		return nil
+	}
+	img := ir.NewImage(id)
+	img.Path = id + "." + o.PreviewFormat
+	img.Format = o.PreviewFormat
+	img.Data = o.Preview
+	return img
+}
+
+// packageFormat returns the file extension of an Office Open XML package by its main part.
+func packageFormat(data []byte) string {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return "zip"
+	}
+	for _, f := range zr.File {
+		switch {
+		case strings.HasPrefix(f.Name, "xl/"):
+			return "xlsx"
+		case strings.HasPrefix(f.Name, "word/"):
+			return "docx"
+		case strings.HasPrefix(f.Name, "ppt/"):
+			return "pptx"
+		}
+	}
+	return "zip"
+}
+
+// chartXML returns the chart part of OOXMLChartContents, which is either the chart XML
+// itself or a package holding it.
+func chartXML(data []byte) []byte {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return data
+	}
+	for _, f := range zr.File {
+		name := strings.ToLower(f.Name)
+		if !strings.Contains(name, "chart") || !strings.HasSuffix(name, ".xml") || strings.Contains(name, "_rels") {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			continue
+		}
+		content, err := io.ReadAll(rc)
+		rc.Close()
+		if err == nil && bytes.Contains(content, []byte("chartSpace")) {
+			return content
+		}
+	}
+	return nil
+}
+
+// parseOle10Native reads a packaged file from an \x01Ole10Native stream:
+// size(4), flags(2), label, source path, reserved(4), temp path length(4) and path,
+// data size(4) and data. Strings are NUL-terminated ANSI.
+func parseOle10Native(data []byte) (string, []byte, bool) {
+	offset := 6
+	cstring := func() (string, bool) {
+		end := bytes.IndexByte(data[min(offset, len(data)):], 0)
+		if end < 0 {
+			return "", false
+		}
+		s := string(data[offset : offset+end])
+		offset += end + 1
+		return s, true
+	}
+	uint32At := func() (int, bool) {
+		if offset+4 > len(data) {
+			return 0, false
+		}
+		v := int(binary.LittleEndian.Uint32(data[offset : offset+4]))
+		offset += 4
+		return v, true
+	}
+
+	label, ok := cstring()
+	if !ok {
+		return "", nil, false
+	}
+	source, ok := cstring()
+	if !ok {
+		return "", nil, false
+	}
+	offset += 4
+	pathLen, ok := uint32At()
+	if !ok || offset+pathLen > len(data) {
+		return "", nil, false
+	}
+	offset += pathLen
+	size, ok := uint32At()
+	if !ok || size < 0 || offset+size > len(data) {
+		return "", nil, false
+	}
+
+	name := label
+	if name == "" {
+		name = filepath.Base(strings.ReplaceAll(source, "\\", "/"))
+	}
+	return name, data[offset : offset+size], true
+}
+
+// parsePresentation reads the picture of an OLE presentation stream (\x02OlePres000):
+// clipboard format, target device, aspect(4), lindex(4), advf(4), reserved(4),
+// width(4) and height(4) in HIMETRIC, data size(4) and data.
+// Only standard formats are read; metafiles get a placeable header and bitmaps a file header.
+func parsePresentation(data []byte) ([]byte, string, bool) {
+	offset := 0
+	uint32At := func() (int, bool) {
+		if offset+4 > len(data) {
+			return 0, false
+		}
+		v := int(binary.LittleEndian.Uint32(data[offset : offset+4]))
+		offset += 4
+		return v, true
+	}
+
+	// 0xFFFFFFFF/0xFFFFFFFE 다음에 표준 형식, 그 밖에는 등록된 형식 이름
+	marker, ok := uint32At()
+	if !ok || (marker != 0xFFFFFFFF && marker != 0xFFFFFFFE) {
+		return nil, "", false
+	}
+	format, ok := uint32At()
+	if !ok {
+		return nil, "", false
+	}
+	deviceSize, ok := uint32At()
+	if !ok || deviceSize < 4 || offset+deviceSize-4 > len(data) {
+		return nil, "", false
+	}
+	offset += deviceSize - 4 + 16
+	width, _ := uint32At()
+	height, _ := uint32At()
+	size, ok := uint32At()
+	if !ok || size <= 0 || offset+size > len(data) {
+		return nil, "", false
+	}
+	content := data[offset : offset+size]
+
+	switch format {
+	case cfMetafilePict:
+		return placeableMetafile(content, width, height), "wmf", true
+	case cfDIB:
+		if bmp := bitmapFile(content); bmp != nil {
+			return bmp, "bmp", true
+		}
+	case cfEnhMetafile:
+		return content, "emf", true
+	}
+	return nil, "", false
+}
+
+// placeableMetafile prepends the placeable header that image viewers expect to a Windows
+// metafile, with its bounds converted from HIMETRIC to twips.
+func placeableMetafile(wmf []byte, width, height int) []byte {
+	header := make([]byte, 22)
+	binary.LittleEndian.PutUint32(header[0:], 0x9AC6CDD7)
+	binary.LittleEndian.PutUint16(header[10:], uint16(min(width*1440/2540, 0x7FFF)))
+	binary.LittleEndian.PutUint16(header[12:], uint16(min(height*1440/2540, 0x7FFF)))
+	binary.LittleEndian.PutUint16(header[14:], 1440)
+	var checksum uint16
+	for k := 0; k < 20; k += 2 {
+		checksum ^= binary.LittleEndian.Uint16(header[k:])
+	}
+	binary.LittleEndian.PutUint16(header[20:], checksum)
+	return append(header, wmf...)
+}
+
+// bitmapFile prepends a BMP file header to a device-independent bitmap.
+// Returns nil if the bitmap header cannot be read.
+func bitmapFile(dib []byte) []byte {
+	if len(dib) < 12 {
+		return nil
+	}
+	headerSize := int(binary.LittleEndian.Uint32(dib))
+	palette := 0
+	if headerSize == 12 {
+		// BITMAPCOREHEADER: 3바이트 색상표
+		if bits := int(binary.LittleEndian.Uint16(dib[10:])); bits <= 8 {
+			palette = 3 << bits
+		}
+	} else {
+		if headerSize < 40 || len(dib) < 40 {
+			return nil
+		}
+		bits := int(binary.LittleEndian.Uint16(dib[14:]))
+		colors := int(binary.LittleEndian.Uint32(dib[32:]))
+		if colors == 0 && bits <= 8 {
+			colors = 1 << bits
+		}
+		palette = colors * 4
+		// BI_BITFIELDS: BITMAPINFOHEADER 뒤에 색상 마스크 3개
+		if headerSize == 40 && binary.LittleEndian.Uint32(dib[16:]) == 3 {
+			palette += 12
+		}
+	}
+	if headerSize+palette > len(dib) {
+		return nil
+	}
+
+	header := make([]byte, 14)
+	copy(header, "BM")
+	binary.LittleEndian.PutUint32(header[2:], uint32(14+len(dib)))
+	binary.LittleEndian.PutUint32(header[10:], uint32(14+headerSize+palette))
+	return append(header, dib...)
+}
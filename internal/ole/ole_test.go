@@ -0,0 +1,346 @@
+package ole
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+	"unicode/utf16"
+)
+
+// makeCompoundFile builds a minimal OLE compound file (512-byte sectors) holding the given
+// root streams. Streams must be at least 4096 bytes so they are stored in regular sectors.
+func makeCompoundFile(t *testing.T, names []string, streams [][]byte) []byte {
+	t.Helper()
+	const (
+		sectorSize = 512
+		freeSect   = 0xFFFFFFFF
+		endOfChain = 0xFFFFFFFE
+		fatSect    = 0xFFFFFFFD
+	)
+	if len(names) > 3 {
+		t.Fatal("at most 3 streams fit in one directory sector")
+	}
+
+	// 0번 섹터는 FAT, 1번은 디렉터리, 이후 스트림 데이터
+	fat := []uint32{fatSect, endOfChain}
+	var body []byte
+	starts := make([]uint32, len(streams))
+	for i, data := range streams {
+		if len(data) < 4096 {
+			t.Fatalf("stream %s is smaller than the mini stream cutoff", names[i])
+		}
+		starts[i] = uint32(len(fat))
+		n := (len(data) + sectorSize - 1) / sectorSize
+		for k := 1; k < n; k++ {
+			fat = append(fat, uint32(len(fat)+1))
+		}
+		fat = append(fat, endOfChain)
+		padded := make([]byte, n*sectorSize)
+		copy(padded, data)
+		body = append(body, padded...)
+	}
+	if len(fat) > sectorSize/4 {
+		t.Fatal("streams do not fit in one FAT sector")
+	}
+
+	header := make([]byte, sectorSize)
+	copy(header, cfbSignature)
+	binary.LittleEndian.PutUint16(header[24:], 0x003E)
+	binary.LittleEndian.PutUint16(header[26:], 3)
+	binary.LittleEndian.PutUint16(header[28:], 0xFFFE)
+	binary.LittleEndian.PutUint16(header[30:], 9)
+	binary.LittleEndian.PutUint16(header[32:], 6)
+	binary.LittleEndian.PutUint32(header[44:], 1) // FAT 섹터 수
+	binary.LittleEndian.PutUint32(header[48:], 1) // 디렉터리 시작 섹터
+	binary.LittleEndian.PutUint32(header[56:], 4096)
+	binary.LittleEndian.PutUint32(header[60:], endOfChain)
+	binary.LittleEndian.PutUint32(header[68:], endOfChain)
+	for k := 0; k < 109; k++ {
+		binary.LittleEndian.PutUint32(header[76+k*4:], freeSect)
+	}
+	binary.LittleEndian.PutUint32(header[76:], 0)
+
+	fatSector := make([]byte, sectorSize)
+	for k := range sectorSize / 4 {
+		v := uint32(freeSect)
+		if k < len(fat) {
+			v = fat[k]
+		}
+		binary.LittleEndian.PutUint32(fatSector[k*4:], v)
+	}
+
+	dir := make([]byte, sectorSize)
+	entry := func(k int, name string, kind byte, right, child, start uint32, size int) {
+		e := dir[k*128 : (k+1)*128]
+		units := utf16.Encode([]rune(name))
+		for j, u := range units {
+			binary.LittleEndian.PutUint16(e[j*2:], u)
+		}
+		binary.LittleEndian.PutUint16(e[64:], uint16((len(units)+1)*2))
+		e[66] = kind
+		e[67] = 1 // 검은색
+		binary.LittleEndian.PutUint32(e[68:], freeSect)
+		binary.LittleEndian.PutUint32(e[72:], right)
+		binary.LittleEndian.PutUint32(e[76:], child)
+		binary.LittleEndian.PutUint32(e[116:], start)
+		binary.LittleEndian.PutUint64(e[120:], uint64(size))
+	}
+	child := uint32(freeSect)
+	if len(names) > 0 {
+		child = 1
+	}
+	entry(0, "Root Entry", 5, freeSect, child, endOfChain, 0)
+	for i, name := range names {
+		right := uint32(freeSect)
+		if i+1 < len(names) {
+			right = uint32(i + 2)
+		}
+		entry(i+1, name, 2, right, freeSect, starts[i], len(streams[i]))
+	}
+	for k := len(names) + 1; k < 4; k++ {
+		e := dir[k*128 : (k+1)*128]
+		binary.LittleEndian.PutUint32(e[68:], freeSect)
+		binary.LittleEndian.PutUint32(e[72:], freeSect)
+		binary.LittleEndian.PutUint32(e[76:], freeSect)
+	}
+
+	data := append(header, fatSector...)
+	data = append(data, dir...)
+	return append(data, body...)
+}
+
+// makeZip builds a zip archive with the given entries.
+func makeZip(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, content := range files {
+		f, err := w.Create(name)
+		if err != nil {
+			t.Fatalf("failed to create zip entry: %v", err)
+		}
+		if _, err := f.Write([]byte(content)); err != nil {
+			t.Fatalf("failed to write zip entry: %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("failed to close zip: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestParse_Package(t *testing.T) {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	if _, err := w.Create("xl/workbook.xml"); err != nil {
+		t.Fatalf("failed to create zip entry: %v", err)
+	}
+	// 일반 섹터에 저장되도록 4096바이트 이상으로 만든다
+	if err := w.SetComment(strings.Repeat("x", 5000)); err != nil {
+		t.Fatalf("failed to set zip comment: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("failed to close zip: %v", err)
+	}
+	pkg := buf.Bytes()
+
+	cfb := makeCompoundFile(t, []string{"Package"}, [][]byte{pkg})
+	// HWP 5.x BinData는 4바이트 길이가 앞에 붙는다
+	data := binary.LittleEndian.AppendUint32(nil, uint32(len(cfb)))
+	data = append(data, cfb...)
+
+	obj, err := Parse(data)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if obj.Format != "xlsx" || !bytes.Equal(obj.Data, pkg) || obj.Chart != nil {
+		t.Errorf("expected xlsx package payload, got format %q (%d bytes)", obj.Format, len(obj.Data))
+	}
+}
+
+// makePresentation builds a presentation stream holding a picture in a standard clipboard format.
+func makePresentation(format uint32, picture []byte) []byte {
+	var data []byte
+	data = binary.LittleEndian.AppendUint32(data, 0xFFFFFFFF)
+	data = binary.LittleEndian.AppendUint32(data, format)
+	data = binary.LittleEndian.AppendUint32(data, 4)    // 대상 장치 없음
+	data = append(data, make([]byte, 16)...)            // aspect, lindex, advf, reserved
+	data = binary.LittleEndian.AppendUint32(data, 2540) // 1인치 (HIMETRIC)
+	data = binary.LittleEndian.AppendUint32(data, 5080)
+	data = binary.LittleEndian.AppendUint32(data, uint32(len(picture)))
+	return append(data, picture...)
+}
+
+// makeDIB builds a 24-bit device-independent bitmap with the given pixel data size.
+func makeDIB(pixels int) []byte {
+	dib := make([]byte, 40+pixels)
+	binary.LittleEndian.PutUint32(dib[0:], 40)
+	binary.LittleEndian.PutUint16(dib[14:], 24)
+	return dib
+}
+
+func TestParse_Chart(t *testing.T) {
+	chart := `<c:chartSpace xmlns:c="c"><!--` + strings.Repeat("x", 4096) + `--></c:chartSpace>`
+	cfb := makeCompoundFile(t, []string{"Contents", "\x02OlePres000", "OOXMLChartContents"},
+		[][]byte{make([]byte, 4096), makePresentation(cfDIB, makeDIB(4096)), []byte(chart)})
+
+	obj, err := Parse(cfb)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if obj.Format != "ole" || !bytes.Equal(obj.Data, cfb) {
+		t.Errorf("expected whole compound file as payload, got format %q", obj.Format)
+	}
+	if string(obj.Chart) != chart {
+		t.Errorf("expected chart XML, got %d bytes", len(obj.Chart))
+	}
+	if obj.PreviewFormat != "bmp" || len(obj.Preview) != 14+40+4096 {
+		t.Errorf("expected bitmap preview, got format %q (%d bytes)", obj.PreviewFormat, len(obj.Preview))
+	}
+}
+
+func TestParsePresentation(t *testing.T) {
+	// 비트맵은 파일 헤더를 붙이고 픽셀 위치를 가리킨다
+	bmp, format, ok := parsePresentation(makePresentation(cfDIB, makeDIB(12)))
+	if !ok || format != "bmp" || string(bmp[:2]) != "BM" || binary.LittleEndian.Uint32(bmp[10:]) != 54 {
+		t.Errorf("unexpected bitmap preview: %q, %v", format, ok)
+	}
+
+	// 메타파일은 placeable 헤더를 붙인다 (1x2인치, 1440 단위)
+	wmf, format, ok := parsePresentation(makePresentation(cfMetafilePict, []byte("metafile")))
+	if !ok || format != "wmf" || len(wmf) != 22+8 {
+		t.Fatalf("unexpected metafile preview: %q, %v", format, ok)
+	}
+	if binary.LittleEndian.Uint32(wmf) != 0x9AC6CDD7 || binary.LittleEndian.Uint16(wmf[10:]) != 1440 ||
+		binary.LittleEndian.Uint16(wmf[12:]) != 2880 || string(wmf[22:]) != "metafile" {
+		t.Errorf("unexpected placeable header: % x", wmf[:22])
+	}
+
+	if _, _, ok := parsePresentation(makePresentation(2, []byte("bitmap handle"))); ok {
+		t.Error("expected failure for an unsupported clipboard format")
+	}
+	if _, _, ok := parsePresentation(makePresentation(cfEnhMetafile, []byte("emf"))[:30]); ok {
+		t.Error("expected failure for truncated data")
+	}
+}
+
+func TestParse_Ole10Native(t *testing.T) {
+	payload := []byte(strings.Repeat("a,b\n", 1024))
+	var stream []byte
+	stream = binary.LittleEndian.AppendUint32(stream, 0)
+	stream = binary.LittleEndian.AppendUint16(stream, 2)
+	stream = append(stream, "budget.csv\x00\x00"...)
+	stream = binary.LittleEndian.AppendUint32(stream, 0x00030000)
+	stream = binary.LittleEndian.AppendUint32(stream, 0)
+	stream = binary.LittleEndian.AppendUint32(stream, uint32(len(payload)))
+	stream = append(stream, payload...)
+
+	obj, err := Parse(makeCompoundFile(t, []string{"\x01Ole10Native"}, [][]byte{stream}))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if obj.Format != "csv" || obj.Name != "budget.csv" || !bytes.Equal(obj.Data, payload) {
+		t.Errorf("expected packaged csv file, got format %q, name %q", obj.Format, obj.Name)
+	}
+}
+
+func TestParse_NotCompoundFile(t *testing.T) {
+	if _, err := Parse([]byte("not an ole object")); err == nil {
+		t.Error("expected error for data without compound file signature")
+	}
+	// 4바이트 길이 뒤의 서명은 인식하지만 잘린 복합 파일은 오류
+	data := append([]byte{0x08, 0x00, 0x00, 0x00}, cfbSignature...)
+	if _, err := Parse(data); err == nil {
+		t.Error("expected error for truncated compound file")
+	}
+}
+
+func TestPackageFormat(t *testing.T) {
+	tests := []struct {
+		entry    string
+		expected string
+	}{
+		{"xl/workbook.xml", "xlsx"},
+		{"word/document.xml", "docx"},
+		{"ppt/presentation.xml", "pptx"},
+		{"other.xml", "zip"},
+	}
+	for _, tt := range tests {
+		data := makeZip(t, map[string]string{"[Content_Types].xml": "<Types/>", tt.entry: "<x/>"})
+		if got := packageFormat(data); got != tt.expected {
+			t.Errorf("packageFormat(%s) = %q, expected %q", tt.entry, got, tt.expected)
+		}
+	}
+	if got := packageFormat([]byte("plain")); got != "zip" {
+		t.Errorf("expected zip for invalid package, got %q", got)
+	}
+}
+
+func TestChartXML(t *testing.T) {
+	chart := `<c:chartSpace xmlns:c="c"/>`
+	if got := chartXML([]byte(chart)); string(got) != chart {
+		t.Errorf("expected plain chart XML to be returned as is, got %q", got)
+	}
+
+	data := makeZip(t, map[string]string{
+		"xl/charts/_rels/chart1.xml.rels": "<Relationships/>",
+		"xl/charts/chart1.xml":            chart,
+	})
+	if got := chartXML(data); string(got) != chart {
+		t.Errorf("expected chart part from package, got %q", got)
+	}
+}
+
+func TestParseOle10Native(t *testing.T) {
+	payload := []byte("a,b\n1,2\n")
+
+	var data []byte
+	data = binary.LittleEndian.AppendUint32(data, 0) // 전체 크기
+	data = binary.LittleEndian.AppendUint16(data, 2)
+	data = append(data, "budget.csv\x00"...)
+	data = append(data, "C:\\docs\\budget.csv\x00"...)
+	data = binary.LittleEndian.AppendUint32(data, 0x00030000)
+	tempPath := "C:\\tmp\\budget.csv\x00"
+	data = binary.LittleEndian.AppendUint32(data, uint32(len(tempPath)))
+	data = append(data, tempPath...)
+	data = binary.LittleEndian.AppendUint32(data, uint32(len(payload)))
+	data = append(data, payload...)
+
+	name, content, ok := parseOle10Native(data)
+	if !ok {
+		t.Fatal("failed to parse Ole10Native stream")
+	}
+	if name != "budget.csv" || !bytes.Equal(content, payload) {
+		t.Errorf("unexpected packaged file: %q, %q", name, content)
+	}
+
+	if _, _, ok := parseOle10Native(data[:len(data)-2]); ok {
+		t.Error("expected failure for truncated data")
+	}
+}
+
+func TestObject_Attachment(t *testing.T) {
+	obj := &Object{Format: "xlsx", Data: []byte("PK")}
+	if a := obj.Attachment("BIN0003"); a.Name != "BIN0003.xlsx" || a.ID != "BIN0003" || !a.HasData() {
+		t.Errorf("unexpected attachment: %+v", a)
+	}
+
+	obj.Name = "예산.xlsx"
+	if a := obj.Attachment("BIN0003"); a.Name != "예산.xlsx" {
+		t.Errorf("expected original file name, got %q", a.Name)
+	}
+}
+
+func TestObject_PreviewImage(t *testing.T) {
+	obj := &Object{Format: "ole"}
+	if img := obj.PreviewImage("BIN0003"); img != nil {
+		t.Errorf("expected no preview image, got %+v", img)
+	}
+
+	obj.Preview, obj.PreviewFormat = []byte("BM"), "bmp"
+	if img := obj.PreviewImage("BIN0003"); img == nil || img.Path != "BIN0003.bmp" || img.Format != "bmp" || !img.HasData() {
+		t.Errorf("unexpected preview image: %+v", img)
+	}
+}
@@ -0,0 +1,33 @@
+package parser
+
+import (
+	"strconv"
+	"strings"
+)
+
+// StyleHeadingLevel returns the heading level implied by a style name, or 0 if none.
+// It recognizes the Hangul built-in styles "개요 1" to "개요 7", "제목" and "부제목",
+// and names carried over from word documents such as "heading 1".
+// The first name that implies a level wins (e.g. the local and English style names).
+func StyleHeadingLevel(names ...string) int {
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+
+		switch name {
+		case "제목", "title":
+			return 1
+		case "부제목", "subtitle":
+			return 2
+		}
+
+		for _, prefix := range []string{"개요", "outline", "heading", "제목"} {
+			if !strings.HasPrefix(name, prefix) {
+				continue
+			}
+			if n, err := strconv.Atoi(strings.TrimSpace(name[len(prefix):])); err == nil && n > 0 {
+				return n
+			}
+		}
+	}
+	return 0
+}
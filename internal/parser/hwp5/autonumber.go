@@ -0,0 +1,110 @@
+package hwp5
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	"github.com/roboco-io/hwp2md/internal/parser"
+)
+
+// 자동 번호 (CTRL_HEADER "atno")와 새 번호 지정 (CTRL_HEADER "nwno")
+// 참조: HWP 5.0 명세서 자동 번호, 새 번호 지정
+//
+// 자동 번호: 속성(4) - bit 0-3 번호 종류 (0 쪽, 1 각주, 2 미주, 3 그림, 4 표, 5 수식),
+// 번호(2), 사용자 기호(2), 앞 장식 문자(2), 뒤 장식 문자(2)
+// 새 번호 지정: 속성(4) - bit 0-3 번호 종류, 번호(2)
+//
+// 캡션의 "표 1", "그림 2"는 "표 " 텍스트 뒤에 자동 번호가 붙은 것이다.
+
+// AutoNumber는 문단 안의 자동 번호 컨트롤
+type AutoNumber struct {
+	Kind   parser.NumberKind
+	Prefix string // 앞 장식 문자
+	Suffix string // 뒤 장식 문자
+}
+
+// parseAutoNumberRecord parses the CTRL_HEADER data of an automatic number control.
+func parseAutoNumberRecord(data []byte) *AutoNumber {
+	if len(data) < 16 {
+		return nil
+	}
+
+	attr := binary.LittleEndian.Uint32(data[4:8])
+	return &AutoNumber{
+		Kind:   parser.NumberKind(attr & 0x0F),
+		Prefix: decorationChar(binary.LittleEndian.Uint16(data[12:14])),
+		Suffix: decorationChar(binary.LittleEndian.Uint16(data[14:16])),
+	}
+}
+
+// decorationChar returns a decoration character, or "" if none is set.
+func decorationChar(c uint16) string {
+	if c == 0 {
+		return ""
+	}
+	return string(rune(c))
+}
+
+// Text returns the text shown for the automatic number n (e.g. "3", "(3)").
+func (a *AutoNumber) Text(n int) string {
+	return a.Prefix + strconv.Itoa(n) + a.Suffix
+}
+
+// parseAutoNumber resolves an automatic number or new number control starting at the CTRL_HEADER record.
+// 그림/표/수식 번호는 문서 순서대로 센 번호를 문단 텍스트의 컨트롤 위치에 넣는다.
+// Returns false if the control is neither.
+func (sp *SectionParser) parseAutoNumber(para *Paragraph, startIdx int, ctrlIndex int) bool {
+	data := sp.records[startIdx].Data
+	switch parseCtrlID(data) {
+	case CtrlAutoNumber:
+		num := parseAutoNumberRecord(data)
+		if num == nil || sp.numbers == nil {
+			return true
+		}
+		if n := sp.numbers.Next(num.Kind); n > 0 {
+			para.insertControlText(para.extendedControlIndex(ctrlIndex), num.Text(n))
+		}
+		return true
+
+	case CtrlNewNumber:
+		if len(data) >= 10 && sp.numbers != nil {
+			kind := parser.NumberKind(binary.LittleEndian.Uint32(data[4:8]) & 0x0F)
+			sp.numbers.Restart(kind, int(binary.LittleEndian.Uint16(data[8:10])))
+		}
+		return true
+	}
+	return false
+}
+
+// insertControlText inserts text at the position of the control at index ctrl in p.Controls.
+// 뒤따르는 컨트롤의 위치와 글자별 PARA_TEXT 위치도 함께 옮긴다.
+func (p *Paragraph) insertControlText(ctrl int, text string) {
+	if ctrl < 0 || ctrl >= len(p.Controls) || text == "" {
+		return
+	}
+	runes := []rune(p.Text)
+	offset := p.Controls[ctrl].Offset
+	if offset < 0 || offset > len(runes) {
+		return
+	}
+	inserted := []rune(text)
+
+	if len(p.charPositions) == len(runes) {
+		// 넣은 글자는 컨트롤 자리에 있는 것으로 본다
+		pos := 0
+		if offset > 0 {
+			pos = p.charPositions[offset-1] + 1
+		}
+		positions := make([]int, 0, len(runes)+len(inserted))
+		positions = append(positions, p.charPositions[:offset]...)
+		for range inserted {
+			positions = append(positions, pos)
+		}
+		p.charPositions = append(positions, p.charPositions[offset:]...)
+	}
+
+	p.Text = string(runes[:offset]) + text + string(runes[offset:])
+	for i := ctrl + 1; i < len(p.Controls); i++ {
+		p.Controls[i].Offset += len(inserted)
+	}
+}
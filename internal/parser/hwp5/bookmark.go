@@ -0,0 +1,74 @@
+package hwp5
+
+import (
+	"encoding/binary"
+	"strings"
+)
+
+// 책갈피와 상호 참조
+// 참조: HWP 5.0 명세서 책갈피 (CTRL_HEADER "bokm"), 필드 시작 ("%bmk", "%xrf"), 파라미터 셋
+//
+// 책갈피 이름은 컨트롤 아래의 CTRL_DATA 레코드(파라미터 셋)에 문자열 아이템으로 저장된다.
+
+// Bookmark는 문단 내 책갈피
+type Bookmark struct {
+	Name   string
+	Offset int // 문단 텍스트 내 위치 (룬 단위, -1이면 문단 끝)
+}
+
+// 파라미터 아이템 형식
+const paramTypeString uint16 = 0x0001
+
+// parseBookmark returns the name of a bookmark control ("bokm" or a "%bmk" field)
+// starting at the CTRL_HEADER record, or "" if the control is not a bookmark.
+func (sp *SectionParser) parseBookmark(startIdx int) string {
+	ctrlRec := sp.records[startIdx]
+	switch parseCtrlID(ctrlRec.Data) {
+	case CtrlBookmark, CtrlFieldBookmark:
+	default:
+		return ""
+	}
+
+	return sp.ctrlDataString(startIdx)
+}
+
+// ctrlDataString returns the first string item of the CTRL_DATA record of a control
+// (책갈피와 필드의 이름), or "" if there is none.
+func (sp *SectionParser) ctrlDataString(startIdx int) string {
+	ctrlRec := sp.records[startIdx]
+	for i := startIdx + 1; i < sp.subtreeEnd(startIdx); i++ {
+		if rec := sp.records[i]; rec.TagID == TagCtrlData && rec.Level == ctrlRec.Level+1 {
+			return parameterSetString(rec.Data)
+		}
+	}
+	return ""
+}
+
+// parameterSetString returns the first string item of a parameter set.
+// 파라미터 셋: ID(2), 아이템 수(2), 예약(2), 아이템마다 ID(2), 형식(2), 값
+// 문자열 값은 길이(2)와 UTF-16LE 글자들이다.
+func parameterSetString(data []byte) string {
+	if len(data) < 6 {
+		return ""
+	}
+	count := int(int16(binary.LittleEndian.Uint16(data[2:4])))
+	pos := 6
+	for n := 0; n < count && pos+4 <= len(data); n++ {
+		itemType := binary.LittleEndian.Uint16(data[pos+2 : pos+4])
+		pos += 4
+		if itemType != paramTypeString || pos+2 > len(data) {
+			// 다른 형식은 크기를 알 수 없으므로 중단
+			return ""
+		}
+		size := int(binary.LittleEndian.Uint16(data[pos:pos+2])) * 2
+		pos += 2
+		if pos+size > len(data) {
+			return ""
+		}
+		if s := strings.TrimSpace(DecodeUTF16LE(data[pos : pos+size])); s != "" {
+			return s
+		}
+		pos += size
+	}
+	return ""
+}
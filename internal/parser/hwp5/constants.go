@@ -95,41 +95,52 @@ const (
 	TagCtrlFormField  uint16 = 0x005B // 양식 컨트롤
 	TagMemoList       uint16 = 0x005C // 메모 리스트
 	TagCellListHeader uint16 = 0x005E // 셀 리스트 헤더
-	TagChartData      uint16 = 0x0060 // 차트 데이터
+	TagChartData      uint16 = 0x0060 // 차트 데이터 (형식이 공개되지 않아 읽지 않음, parseOLE 참고)
 	TagVideoData      uint16 = 0x0062 // 비디오 데이터
 )
 
 // 컨트롤 타입 ID (4바이트 문자열)
 const (
-	CtrlSection       = "secd" // 구역 정의
-	CtrlColumn        = "cold" // 단 정의
-	CtrlHeader        = "head" // 머리말
-	CtrlFooter        = "foot" // 꼬리말
-	CtrlFootnote      = "fn  " // 각주
-	CtrlEndnote       = "en  " // 미주
-	CtrlAutoNumber    = "atno" // 자동 번호
-	CtrlNewNumber     = "nwno" // 새 번호
-	CtrlPageHide      = "pghd" // 페이지 숨김
-	CtrlPageOddEven   = "pgct" // 홀/짝수 페이지
-	CtrlPageNumber    = "pgno" // 페이지 번호
-	CtrlIndexMark     = "idxm" // 찾아보기 표식
-	CtrlBookmark      = "bokm" // 책갈피
-	CtrlOverlapping   = "tcps" // 글자 겹침
-	CtrlHiddenComment = "tdut" // 숨은 설명
-	CtrlTable         = "tbl " // 표
-	CtrlGSO           = "gso " // 그리기 개체
-	CtrlEquation      = "eqed" // 수식
-	CtrlFieldBegin    = "%beg" // 필드 시작
-	CtrlFieldEnd      = "%end" // 필드 끝
+	CtrlSection        = "secd" // 구역 정의
+	CtrlColumn         = "cold" // 단 정의
+	CtrlHeader         = "head" // 머리말
+	CtrlFooter         = "foot" // 꼬리말
+	CtrlFootnote       = "fn  " // 각주
+	CtrlEndnote        = "en  " // 미주
+	CtrlAutoNumber     = "atno" // 자동 번호
+	CtrlNewNumber      = "nwno" // 새 번호
+	CtrlPageHide       = "pghd" // 페이지 숨김
+	CtrlPageOddEven    = "pgct" // 홀/짝수 페이지
+	CtrlPageNumber     = "pgno" // 페이지 번호
+	CtrlIndexMark      = "idxm" // 찾아보기 표식
+	CtrlBookmark       = "bokm" // 책갈피
+	CtrlOverlapping    = "tcps" // 글자 겹침
+	CtrlHiddenComment  = "tdut" // 숨은 설명
+	CtrlTable          = "tbl " // 표
+	CtrlGSO            = "gso " // 그리기 개체
+	CtrlEquation       = "eqed" // 수식
+	CtrlForm           = "form" // 양식 개체
+	CtrlFieldBegin     = "%beg" // 필드 시작
+	CtrlFieldEnd       = "%end" // 필드 끝
+	CtrlFieldHyperlink = "%hlk" // 하이퍼링크 필드
+	CtrlFieldClickHere = "%clk" // 누름틀 필드
+	CtrlFieldDate      = "%dte" // 날짜 필드
+	CtrlFieldBookmark  = "%bmk" // 책갈피 필드 (구간 책갈피)
+	CtrlFieldCrossRef  = "%xrf" // 상호 참조 필드
+	CtrlFieldMemo      = "%%me" // 메모 필드
+	CtrlFieldRevDelete = "%%*d" // 교정 부호 - 지움표 (삭제 구간)
+	CtrlFieldRevInsert = "%%*e" // 교정 부호 - 넣음표 (삽입 구간)
 )
 
 // 특수 문자 코드
 const (
 	CharLine           = 0x0000 // 줄 나눔
+	CharLineBreak      = 0x000A // 강제 줄 나눔
 	CharPara           = 0x000D // 문단 나눔
 	CharTab            = 0x0009 // 탭
 	CharDrawingObj     = 0x000B // 그리기 개체/표
 	CharInlineStart    = 0x000C // 인라인 시작
+	CharFootnote       = 0x0011 // 각주/미주
 	CharFieldStart     = 0x0003 // 필드 시작
 	CharFieldEnd       = 0x0004 // 필드 끝
 	CharBookmark       = 0x0005 // 책갈피
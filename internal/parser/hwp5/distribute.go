@@ -0,0 +1,97 @@
+package hwp5
+
+import (
+	"crypto/aes"
+	"encoding/binary"
+	"fmt"
+)
+
+// 배포용 문서 (ViewText) 복호화
+// 참조: HWP 5.0 명세서 3.2.11 본문 - 배포용 문서
+//
+// 배포용 문서의 본문은 BodyText 대신 ViewText/SectionN 스트림에 저장된다.
+// 각 스트림은 256바이트 DISTRIBUTE_DOC_DATA 레코드로 시작하고, 나머지는
+// 이 레코드에서 얻은 키로 AES-128 (ECB) 암호화되어 있다.
+
+const distributeDocDataSize = 256
+
+// msvcRand is the linear congruential generator of the Microsoft C runtime (srand/rand).
+type msvcRand struct {
+	seed uint32
+}
+
+func (r *msvcRand) next() uint32 {
+	r.seed = r.seed*214013 + 2531011
+	return (r.seed >> 16) & 0x7FFF
+}
+
+// decodeDistributeDocData removes the XOR mask of a DISTRIBUTE_DOC_DATA payload.
+// 처음 4바이트는 난수 시드이고, 이후 바이트는 시드로 만든 난수 값과 XOR 되어 있다.
+// 같은 값이 1~16바이트 동안 반복된다. XOR이므로 같은 함수로 다시 씌울 수 있다.
+func decodeDistributeDocData(data []byte) []byte {
+	decoded := make([]byte, len(data))
+	copy(decoded, data)
+	if len(decoded) < 4 {
+		return decoded
+	}
+
+	rand := &msvcRand{seed: binary.LittleEndian.Uint32(decoded[0:4])}
+	var key byte
+	count := 0
+	for i := range decoded {
+		if count == 0 {
+			key = byte(rand.next() & 0xFF)
+			count = int(rand.next()&0x0F) + 1
+		}
+		if i >= 4 {
+			decoded[i] ^= key
+		}
+		count--
+	}
+	return decoded
+}
+
+// distributeKey returns the AES key stored in a DISTRIBUTE_DOC_DATA payload.
+// 복호화된 데이터의 4 + (시드 & 0x0F) 위치부터 SHA-1 해시 문자열이 있으며, 앞 16바이트가 키다.
+func distributeKey(data []byte) ([]byte, error) {
+	if len(data) != distributeDocDataSize {
+		return nil, fmt.Errorf("배포용 문서 데이터 크기가 잘못되었습니다: %d", len(data))
+	}
+
+	decoded := decodeDistributeDocData(data)
+	offset := 4 + int(decoded[0]&0x0F)
+	return decoded[offset : offset+aes.BlockSize], nil
+}
+
+// DecryptViewText decrypts a ViewText/SectionN stream of a distributable document.
+// The result is still compressed if the document is compressed.
+func DecryptViewText(data []byte) ([]byte, error) {
+	reader := NewRecordReader(data)
+	rec, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("배포용 문서 데이터를 읽을 수 없습니다: %w", err)
+	}
+	if rec.TagID != TagDistributeDocData {
+		return nil, fmt.Errorf("배포용 문서 데이터 레코드가 없습니다 (tag 0x%04X)", rec.TagID)
+	}
+
+	key, err := distributeKey(rec.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("AES 키 생성 실패: %w", err)
+	}
+
+	// ECB: 16바이트 블록을 각각 복호화 (남는 바이트는 버림)
+	encrypted := data[reader.offset:]
+	size := len(encrypted) - len(encrypted)%aes.BlockSize
+	decrypted := make([]byte, size)
+	for i := 0; i < size; i += aes.BlockSize {
+		block.Decrypt(decrypted[i:i+aes.BlockSize], encrypted[i:i+aes.BlockSize])
+	}
+
+	return decrypted, nil
+}
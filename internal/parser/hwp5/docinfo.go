@@ -3,6 +3,9 @@ package hwp5
 import (
 	"encoding/binary"
 	"fmt"
+
+	"github.com/roboco-io/hwp2md/internal/ir"
+	"github.com/roboco-io/hwp2md/internal/parser"
 )
 
 // DocInfo는 문서 정보 스트림에서 파싱된 데이터
@@ -11,9 +14,15 @@ type DocInfo struct {
 	IDMappings  *IDMappings
 	BinDataList []*BinDataInfo
 	FaceNames   []string
+	BorderFills []*BorderFill
 	CharShapes  []*CharShape
 	ParaShapes  []*ParaShape
 	Styles      []*Style
+	Numberings  []*Numbering
+	Bullets     []*Bullet
+
+	TrackChanges       []*TrackChange // 변경 내역 (ID 순서, 1부터)
+	TrackChangeAuthors []string       // 변경 내역 작성자 이름 (ID 순서, 1부터)
 }
 
 // DocumentProperties는 문서 속성 (HWPTAG_DOCUMENT_PROPERTIES)
@@ -61,6 +70,16 @@ type BinDataInfo struct {
 	Extension string // 확장자
 }
 
+// BorderFill은 테두리/배경 (HWPTAG_BORDER_FILL)
+// 테두리선과 대각선은 건너뛰고 채우기 정보만 읽는다.
+type BorderFill struct {
+	Attributes      uint16 // 속성
+	FillType        uint32 // 채우기 종류 (비트 0: 단색, 비트 1: 그림, 비트 2: 그러데이션)
+	BackgroundColor uint32 // 단색 채우기의 배경색
+	PatternColor    uint32 // 무늬색
+	PatternType     int32  // 무늬 종류 (-1이면 무늬 없음)
+}
+
 // CharShape는 글자 모양 (HWPTAG_CHAR_SHAPE)
 type CharShape struct {
 	FaceID       [7]uint16 // 언어별 글꼴 ID
@@ -114,6 +133,34 @@ type Style struct {
 	LockForm    bool   // 잠금 여부
 }
 
+// NumberingLevel은 문단 번호의 수준별 정보
+type NumberingLevel struct {
+	Attributes  uint32 // 문단 머리 정보 속성
+	CharShapeID uint32 // 글자 모양 ID
+	Format      string // 번호 형식 문자열 (예: "^1.", "^2)")
+	Start       uint32 // 수준별 시작 번호
+}
+
+// Numbering은 문단 번호 정의 (HWPTAG_NUMBERING)
+type Numbering struct {
+	Levels [7]NumberingLevel // 수준 1~7
+	Start  uint16            // 시작 번호
+}
+
+// Bullet은 글머리표 정의 (HWPTAG_BULLET)
+type Bullet struct {
+	Attributes  uint32 // 문단 머리 정보 속성
+	CharShapeID uint32 // 글자 모양 ID
+	Char        rune   // 글머리표 문자
+}
+
+// TrackChange는 변경 내역 (HWPTAG_TRACK_CHANGE)
+// 교정 부호 필드의 ID가 이 목록의 순번(1부터)을 가리킨다.
+type TrackChange struct {
+	Type     uint32 // 변경 종류 (삽입, 삭제, 서식 변경 등)
+	AuthorID uint16 // 작성자 ID (1부터, 0이면 없음)
+}
+
 // ParseDocInfo parses the DocInfo stream.
 func ParseDocInfo(data []byte) (*DocInfo, error) {
 	reader := NewRecordReader(data)
@@ -138,6 +185,10 @@ func ParseDocInfo(data []byte) (*DocInfo, error) {
 		case TagFaceName:
 			name := parseFaceName(rec.Data)
 			info.FaceNames = append(info.FaceNames, name)
+		case TagBorderFill:
+			if bf := parseBorderFill(rec.Data); bf != nil {
+				info.BorderFills = append(info.BorderFills, bf)
+			}
 		case TagCharShape:
 			cs := parseCharShape(rec.Data)
 			if cs != nil {
@@ -153,12 +204,34 @@ func ParseDocInfo(data []byte) (*DocInfo, error) {
 			if style != nil {
 				info.Styles = append(info.Styles, style)
 			}
+		case TagNumbering:
+			if numbering := parseNumbering(rec.Data); numbering != nil {
+				info.Numberings = append(info.Numberings, numbering)
+			}
+		case TagBullet:
+			if bullet := parseBullet(rec.Data); bullet != nil {
+				info.Bullets = append(info.Bullets, bullet)
+			}
+		case TagTrackChange2:
+			// 읽을 수 없는 레코드도 자리를 차지해야 뒤의 ID가 어긋나지 않는다
+			info.TrackChanges = append(info.TrackChanges, parseTrackChange(rec.Data))
+		case TagTrackChangeAuthor:
+			info.TrackChangeAuthors = append(info.TrackChangeAuthors, parseTrackChangeAuthor(rec.Data))
 		}
 	}
 
 	return info, nil
 }
 
+// AutoNumbers returns counters for caption numbers starting at the document start numbers.
+func (d *DocInfo) AutoNumbers() *parser.AutoNumbers {
+	if d == nil || d.Properties == nil {
+		return parser.NewAutoNumbers(1, 1, 1)
+	}
+	props := d.Properties
+	return parser.NewAutoNumbers(int(props.PictureStart), int(props.TableStart), int(props.EquationStart))
+}
+
 func parseDocumentProperties(data []byte) *DocumentProperties {
 	if len(data) < 26 {
 		return nil
@@ -278,6 +351,40 @@ func parseFaceName(data []byte) string {
 	return DecodeUTF16LE(data[offset : offset+nameLen*2])
 }
 
+func parseTrackChange(data []byte) *TrackChange {
+	if len(data) < 6 {
+		return &TrackChange{}
+	}
+	return &TrackChange{
+		Type:     binary.LittleEndian.Uint32(data[0:4]),
+		AuthorID: binary.LittleEndian.Uint16(data[4:6]),
+	}
+}
+
+// parseTrackChangeAuthor returns the name of a track change author (길이 2바이트 + UTF-16LE).
+func parseTrackChangeAuthor(data []byte) string {
+	if len(data) < 2 {
+		return ""
+	}
+	nameLen := int(binary.LittleEndian.Uint16(data[0:2]))
+	if 2+nameLen*2 > len(data) {
+		return ""
+	}
+	return DecodeUTF16LE(data[2 : 2+nameLen*2])
+}
+
+// ChangeAuthor returns the author name of a tracked change, or "" if it is unknown.
+func (d *DocInfo) ChangeAuthor(id uint32) string {
+	if d == nil || id == 0 || int(id) > len(d.TrackChanges) {
+		return ""
+	}
+	author := int(d.TrackChanges[id-1].AuthorID)
+	if author == 0 || author > len(d.TrackChangeAuthors) {
+		return ""
+	}
+	return d.TrackChangeAuthors[author-1]
+}
+
 func parseCharShape(data []byte) *CharShape {
 	if len(data) < 72 {
 		return nil
@@ -367,6 +474,46 @@ func parseParaShape(data []byte) *ParaShape {
 	return ps
 }
 
+// parseBorderFill parses a BORDER_FILL record.
+// 참조: HWP 5.0 명세서 4.2.5 테두리/배경
+// [0:2] 속성, [2:26] 4방향 테두리선 (종류 1, 굵기 1, 색 4), [26:32] 대각선,
+// [32:36] 채우기 종류, 단색이면 배경색(4), 무늬색(4), 무늬 종류(4)
+func parseBorderFill(data []byte) *BorderFill {
+	if len(data) < 36 {
+		return nil
+	}
+
+	bf := &BorderFill{
+		Attributes:  binary.LittleEndian.Uint16(data[0:2]),
+		FillType:    binary.LittleEndian.Uint32(data[32:36]),
+		PatternType: -1,
+	}
+	if bf.FillType&0x01 != 0 && len(data) >= 48 {
+		bf.BackgroundColor = binary.LittleEndian.Uint32(data[36:40])
+		bf.PatternColor = binary.LittleEndian.Uint32(data[40:44])
+		bf.PatternType = int32(binary.LittleEndian.Uint32(data[44:48]))
+	}
+	return bf
+}
+
+// Background returns the solid fill colour as "#RRGGBB", or "" if the fill is not a visible colour.
+// 색 없음(0xFFFFFFFF)과 흰색은 채우기 없음으로 본다.
+func (bf *BorderFill) Background() string {
+	if bf.FillType&0x01 == 0 || bf.BackgroundColor&0x00FFFFFF == 0x00FFFFFF {
+		return ""
+	}
+	return colorRefToHex(bf.BackgroundColor)
+}
+
+// BorderFill returns the border/fill with the given ID, or nil if not found.
+// 테두리/배경 ID는 1부터 시작한다.
+func (d *DocInfo) BorderFill(id uint16) *BorderFill {
+	if d == nil || id == 0 || int(id) > len(d.BorderFills) {
+		return nil
+	}
+	return d.BorderFills[id-1]
+}
+
 func parseStyle(data []byte) *Style {
 	if len(data) < 2 {
 		return nil
@@ -438,27 +585,182 @@ func (info *BinDataInfo) GetBinDataPath() string {
 	return fmt.Sprintf("BIN%04X.%s", info.BinDataID, info.Extension)
 }
 
+// IsCompressed reports whether the binary data stream is compressed.
+// 속성 비트 4-5: 0=문서 설정을 따름, 1=압축, 2=압축하지 않음
+func (info *BinDataInfo) IsCompressed(docCompressed bool) bool {
+	switch (info.Type >> 4) & 0x03 {
+	case 1:
+		return true
+	case 2:
+		return false
+	default:
+		return docCompressed
+	}
+}
+
 // IsBold returns true if the character shape is bold.
+// 속성 비트 0: 기울임, 비트 1: 진하게
 func (cs *CharShape) IsBold() bool {
-	return cs.Attributes&0x01 != 0
+	return cs.Attributes&0x02 != 0
 }
 
 // IsItalic returns true if the character shape is italic.
 func (cs *CharShape) IsItalic() bool {
-	return cs.Attributes&0x02 != 0
+	return cs.Attributes&0x01 != 0
 }
 
 // IsUnderline returns true if the character shape has underline.
 func (cs *CharShape) IsUnderline() bool {
-	return (cs.Attributes>>3)&0x07 != 0 // bits 3-5
+	return (cs.Attributes>>2)&0x03 != 0 // bits 2-3 (밑줄 종류)
 }
 
 // IsStrikeout returns true if the character shape has strikeout.
 func (cs *CharShape) IsStrikeout() bool {
-	return (cs.Attributes>>6)&0x07 != 0 // bits 6-8
+	return (cs.Attributes>>18)&0x07 != 0 // bits 18-20
+}
+
+// IsSuperscript returns true if the character shape is superscript.
+func (cs *CharShape) IsSuperscript() bool {
+	return cs.Attributes&(1<<15) != 0
+}
+
+// IsSubscript returns true if the character shape is subscript.
+func (cs *CharShape) IsSubscript() bool {
+	return cs.Attributes&(1<<16) != 0
+}
+
+// HasShade returns true if the character shape has a visible shade (background) colour.
+// 음영 색이 없음(0xFFFFFFFF) 또는 흰색이면 음영 없음으로 본다.
+func (cs *CharShape) HasShade() bool {
+	return cs.ShadeColor&0x00FFFFFF != 0x00FFFFFF
+}
+
+// ShadeColorHex returns the shade colour as "#RRGGBB".
+// COLORREF는 0x00BBGGRR 형식
+func (cs *CharShape) ShadeColorHex() string {
+	return colorRefToHex(cs.ShadeColor)
 }
 
 // GetFontSizePt returns the font size in points.
 func (cs *CharShape) GetFontSizePt() float64 {
 	return float64(cs.Height) / 100.0
 }
+
+// parseNumbering parses a NUMBERING record.
+// 참조: HWP 5.0 명세서 4.2.9 문단 번호
+func parseNumbering(data []byte) *Numbering {
+	numbering := &Numbering{}
+	offset := 0
+
+	// 수준 1~7: 문단 머리 정보(12) + 번호 형식 길이(2) + 번호 형식(WCHAR 배열)
+	for level := range numbering.Levels {
+		if offset+14 > len(data) {
+			return nil
+		}
+		lv := &numbering.Levels[level]
+		lv.Attributes = binary.LittleEndian.Uint32(data[offset : offset+4])
+		lv.CharShapeID = binary.LittleEndian.Uint32(data[offset+8 : offset+12])
+		offset += 12
+
+		fmtLen := int(binary.LittleEndian.Uint16(data[offset : offset+2]))
+		offset += 2
+		if offset+fmtLen*2 > len(data) {
+			return nil
+		}
+		lv.Format = DecodeUTF16LE(data[offset : offset+fmtLen*2])
+		offset += fmtLen * 2
+	}
+
+	// 시작 번호
+	numbering.Start = 1
+	if offset+2 <= len(data) {
+		numbering.Start = binary.LittleEndian.Uint16(data[offset : offset+2])
+		offset += 2
+	}
+
+	// 수준별 시작 번호 (5.0.2.5 이상)
+	for level := range numbering.Levels {
+		numbering.Levels[level].Start = 1
+		if offset+4 <= len(data) {
+			numbering.Levels[level].Start = binary.LittleEndian.Uint32(data[offset : offset+4])
+			offset += 4
+		}
+	}
+
+	return numbering
+}
+
+// parseBullet parses a BULLET record.
+// 참조: HWP 5.0 명세서 4.2.10 글머리표
+func parseBullet(data []byte) *Bullet {
+	if len(data) < 14 {
+		return nil
+	}
+
+	return &Bullet{
+		Attributes:  binary.LittleEndian.Uint32(data[0:4]),
+		CharShapeID: binary.LittleEndian.Uint32(data[8:12]),
+		Char:        rune(binary.LittleEndian.Uint16(data[12:14])),
+	}
+}
+
+// HeadingLevel returns the heading level implied by the style name, or 0 if none.
+// 한글 기본 스타일 "개요 1"~"개요 7"과 워드 문서에서 넘어온 "heading 1" 등을 인식한다.
+func (s *Style) HeadingLevel() int {
+	return parser.StyleHeadingLevel(s.Name, s.EngName)
+}
+
+// 문단 머리 모양 종류 (ParaShape 속성 1의 비트 23-24)
+const (
+	ParaHeadNone      = 0 // 없음
+	ParaHeadOutline   = 1 // 개요
+	ParaHeadNumbering = 2 // 번호
+	ParaHeadBullet    = 3 // 글머리표
+)
+
+// HeadType returns the paragraph head type (none, outline, numbering or bullet).
+func (ps *ParaShape) HeadType() int {
+	return int((ps.Attributes1 >> 23) & 0x03)
+}
+
+// Level returns the paragraph level (0-6) used by outline, numbering and bullets.
+func (ps *ParaShape) Level() int {
+	return int((ps.Attributes1 >> 25) & 0x07)
+}
+
+// 문단 정렬 방식 (ParaShape 속성 1의 비트 2-4)
+const (
+	AlignJustify    = 0 // 양쪽 정렬
+	AlignLeft       = 1 // 왼쪽 정렬
+	AlignRight      = 2 // 오른쪽 정렬
+	AlignCenter     = 3 // 가운데 정렬
+	AlignDistribute = 4 // 배분 정렬
+	AlignDivide     = 5 // 나눔 정렬
+)
+
+// Alignment returns the IR alignment (left, center, right) of the paragraph shape,
+// or "" for justified and distributed paragraphs.
+func (ps *ParaShape) Alignment() string {
+	switch (ps.Attributes1 >> 2) & 0x07 {
+	case AlignLeft:
+		return ir.AlignLeft
+	case AlignRight:
+		return ir.AlignRight
+	case AlignCenter:
+		return ir.AlignCenter
+	}
+	return ""
+}
+
+// OutlineLevel returns the 1-based outline level, or 0 if the paragraph is not an outline paragraph.
+func (ps *ParaShape) OutlineLevel() int {
+	if ps.HeadType() != ParaHeadOutline {
+		return 0
+	}
+	return ps.Level() + 1
+}
+
+// colorRefToHex converts a COLORREF (0x00BBGGRR) to "#RRGGBB".
+func colorRefToHex(c uint32) string {
+	return fmt.Sprintf("#%02X%02X%02X", c&0xFF, (c>>8)&0xFF, (c>>16)&0xFF)
+}
@@ -0,0 +1,46 @@
+package hwp5
+
+import (
+	"encoding/binary"
+)
+
+// Equation은 수식 개체 (CTRL_HEADER "eqed" + EQEDIT)
+// 참조: HWP 5.0 명세서 4.3.9.7 수식 개체
+type Equation struct {
+	Offset int    // 문단 텍스트 내 위치 (룬 단위, -1이면 문단 끝)
+	Script string // 한글 수식 스크립트 (예: "{a} over {b}")
+}
+
+// parseEquation parses an equation control starting at the CTRL_HEADER record.
+// Returns nil if the control is not an equation.
+func (sp *SectionParser) parseEquation(startIdx int, offset int) *Equation {
+	ctrlRec := sp.records[startIdx]
+	if parseCtrlID(ctrlRec.Data) != CtrlEquation {
+		return nil
+	}
+
+	eq := &Equation{Offset: offset}
+	end := sp.subtreeEnd(startIdx)
+	for i := startIdx + 1; i < end; i++ {
+		rec := sp.records[i]
+		if rec.TagID == TagEqEdit {
+			eq.Script = parseEqEditScript(rec.Data)
+			break
+		}
+	}
+
+	return eq
+}
+
+// parseEqEditScript returns the script of an EQEDIT record.
+// [0:4] 속성, [4:6] 스크립트 길이, 이후 스크립트 (WCHAR)
+func parseEqEditScript(data []byte) string {
+	if len(data) < 6 {
+		return ""
+	}
+	length := int(binary.LittleEndian.Uint16(data[4:6]))
+	if 6+length*2 > len(data) {
+		return ""
+	}
+	return DecodeUTF16LE(data[6 : 6+length*2])
+}
@@ -0,0 +1,219 @@
+package hwp5
+
+import (
+	"encoding/binary"
+	"strconv"
+	"strings"
+
+	"github.com/roboco-io/hwp2md/internal/ir"
+	"github.com/roboco-io/hwp2md/internal/parser"
+)
+
+// Field는 문단 내 필드 (필드 시작 ~ 필드 끝 사이의 텍스트 구간)
+// 참조: HWP 5.0 명세서 4.3.10.14 필드 시작
+type Field struct {
+	CtrlID     string // 필드 종류 ("%hlk" 등)
+	Attributes uint32
+	Command    string // 필드 명령 (하이퍼링크는 URL과 옵션)
+	ID         uint32
+	Name       string // 필드 이름 (누름틀 등, CTRL_DATA)
+	Start      int    // 문단 텍스트 내 시작 위치 (룬 단위)
+	End        int    // 끝 위치 (룬 단위, 포함하지 않음, -1이면 문단 끝)
+}
+
+// IsHyperlink reports whether the field is a hyperlink.
+func (f *Field) IsHyperlink() bool {
+	return f.CtrlID == CtrlFieldHyperlink
+}
+
+// URL returns the link target of a hyperlink field, the bookmark anchor (#id) of a
+// cross-reference field, or "" for other fields.
+func (f *Field) URL() string {
+	switch f.CtrlID {
+	case CtrlFieldHyperlink:
+		return parser.HyperlinkURL(f.Command)
+	case CtrlFieldCrossRef:
+		if id := ir.AnchorID(parser.CrossRefTarget(f.Command)); id != "" {
+			return "#" + id
+		}
+	}
+	return ""
+}
+
+// IsClickHere reports whether the field is a click-here (누름틀) field of a form.
+func (f *Field) IsClickHere() bool {
+	return f.CtrlID == CtrlFieldClickHere
+}
+
+// IsMemo reports whether the field is a memo (메모) anchor.
+func (f *Field) IsMemo() bool {
+	return f.CtrlID == CtrlFieldMemo
+}
+
+// Change returns "insert" or "delete" for a revision field, or "" for other fields.
+func (f *Field) Change() string {
+	switch f.CtrlID {
+	case CtrlFieldRevInsert:
+		return "insert"
+	case CtrlFieldRevDelete:
+		return "delete"
+	}
+	return ""
+}
+
+// Parameter returns a named value of the field command, or "" if it is absent.
+// 메모 등의 명령은 "이름:형식:길이:값" 항목이 이어진 매개변수 집합이다
+// (예: "Author:wstring:3:홍길동 CreateDateTime:wstring:...").
+func (f *Field) Parameter(name string) string {
+	return parameterValue(f.Command, name)
+}
+
+// parameterValue returns a named value of a parameter string, or "" if it is absent.
+// 문자열(wstring)은 "이름:wstring:길이:값", 그 밖의 형식은 "이름:형식:값" 꼴이다.
+// 묶음(set)은 "이름:set:길이:" 뒤에 항목이 이어지므로 안쪽 항목도 찾을 수 있다.
+func parameterValue(params, name string) string {
+	runes := []rune(params)
+	prefix := []rune(name + ":")
+	for i := 0; i+len(prefix) <= len(runes); i++ {
+		if string(runes[i:i+len(prefix)]) != string(prefix) || (i > 0 && runes[i-1] != ' ' && runes[i-1] != ':') {
+			continue
+		}
+
+		rest := string(runes[i+len(prefix):])
+		kind, rest, ok := strings.Cut(rest, ":")
+		if !ok {
+			return ""
+		}
+		if kind != "wstring" {
+			value, _, _ := strings.Cut(rest, " ")
+			return value
+		}
+
+		// 문자열 길이
+		length, rest, ok := strings.Cut(rest, ":")
+		n, err := strconv.Atoi(length)
+		if !ok || err != nil || n < 0 {
+			return ""
+		}
+		value := []rune(rest)
+		if n > len(value) {
+			n = len(value)
+		}
+		return strings.TrimSpace(string(value[:n]))
+	}
+	return ""
+}
+
+// contains reports whether the rune offset lies inside the field.
+func (f *Field) contains(offset int) bool {
+	return offset >= f.Start && (f.End < 0 || offset < f.End)
+}
+
+// isFieldCtrlID reports whether a control ID is a field start ("%xxx").
+func isFieldCtrlID(id string) bool {
+	return strings.HasPrefix(id, "%") && id != CtrlFieldBegin && id != CtrlFieldEnd
+}
+
+// parseField parses a field start control starting at the CTRL_HEADER record.
+// Returns nil if the control is not a field.
+func (sp *SectionParser) parseField(startIdx int, offset int) *Field {
+	data := sp.records[startIdx].Data
+	id := parseCtrlID(data)
+	if !isFieldCtrlID(id) {
+		return nil
+	}
+
+	field := &Field{CtrlID: id, Start: offset, End: -1}
+	if len(data) >= 8 {
+		field.Attributes = binary.LittleEndian.Uint32(data[4:8])
+	}
+
+	// [8] 기타 속성, [9:11] 명령 길이, 이후 명령 문자열, 필드 ID
+	if len(data) >= 11 {
+		cmdLen := int(binary.LittleEndian.Uint16(data[9:11]))
+		cmdEnd := 11 + cmdLen*2
+		if cmdEnd <= len(data) {
+			field.Command = DecodeUTF16LE(data[11:cmdEnd])
+			if cmdEnd+4 <= len(data) {
+				field.ID = binary.LittleEndian.Uint32(data[cmdEnd : cmdEnd+4])
+			}
+		}
+	}
+
+	if field.IsClickHere() {
+		field.Name = sp.ctrlDataString(startIdx)
+	}
+
+	if field.Start < 0 {
+		field.Start = 0
+	}
+	return field
+}
+
+// resolveFieldEnds sets the end offsets of the paragraph fields from its field end characters.
+// 필드 시작 문자와 필드 끝 문자는 괄호처럼 짝을 이룬다.
+func (p *Paragraph) resolveFieldEnds() {
+	var stack []*Field
+	next := 0
+	for _, ctrl := range p.Controls {
+		switch ctrl.Type {
+		case CharFieldStart:
+			if next < len(p.Fields) {
+				stack = append(stack, p.Fields[next])
+				next++
+			}
+		case CharFieldEnd:
+			if len(stack) > 0 {
+				stack[len(stack)-1].End = ctrl.Offset
+				stack = stack[:len(stack)-1]
+			}
+		}
+	}
+}
+
+// text returns the paragraph text covered by the field.
+func (f *Field) text(para *Paragraph) string {
+	runes := []rune(para.Text)
+	start, end := min(f.Start, len(runes)), f.End
+	if end < 0 || end > len(runes) {
+		end = len(runes)
+	}
+	if end < start {
+		return ""
+	}
+	return strings.TrimSpace(string(runes[start:end]))
+}
+
+// linkAt returns the URL of the innermost hyperlink or cross-reference covering the rune offset.
+func (p *Paragraph) linkAt(offset int) string {
+	link := ""
+	for _, f := range p.Fields {
+		if url := f.URL(); url != "" && f.contains(offset) {
+			link = url
+		}
+	}
+	return link
+}
+
+// changeAt returns the change kind ("insert"/"delete") and the field ID of the innermost
+// revision field covering the rune offset.
+func (p *Paragraph) changeAt(offset int) (string, uint32) {
+	change, id := "", uint32(0)
+	for _, f := range p.Fields {
+		if c := f.Change(); c != "" && f.contains(offset) {
+			change, id = c, f.ID
+		}
+	}
+	return change, id
+}
+
+// memoAt returns the innermost memo field covering the rune offset.
+func (p *Paragraph) memoAt(offset int) *Field {
+	var memo *Field
+	for _, f := range p.Fields {
+		if f.IsMemo() && f.contains(offset) {
+			memo = f
+		}
+	}
+	return memo
+}
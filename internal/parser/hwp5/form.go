@@ -0,0 +1,115 @@
+package hwp5
+
+import (
+	"encoding/binary"
+	"strings"
+
+	"github.com/roboco-io/hwp2md/internal/ir"
+)
+
+// 양식 개체 (CTRL_HEADER "form", FORM_OBJECT)
+// 참조: HWP 5.0 명세서 양식 개체
+//
+// FORM_OBJECT는 개체 종류 ID 뒤에 속성 문자열을 둔다. 속성 문자열은 필드 명령과 같은
+// "이름:형식:값" 매개변수 집합이며 첫 단어가 개체 종류다
+// (예: "CheckBtn set:220:CommonSet:set:139:Name:wstring:9:CheckBox1 ... Value:int:1 ...").
+// 문자열 길이 필드의 위치는 명세에 자세히 나와 있지 않아 알려진 배치를 차례로 시도한다.
+
+// FormObject는 문단 안의 양식 개체 (체크 박스, 라디오 단추, 입력 상자 등)
+type FormObject struct {
+	Type    ir.FormFieldType
+	Name    string
+	Value   string // 체크 박스/라디오 단추는 "true" 또는 "false"
+	Caption string
+	Offset  int // 문단 텍스트 내 위치 (룬 단위, -1이면 문단 끝)
+}
+
+// Field returns the IR form field of the object.
+func (f *FormObject) Field() *ir.FormField {
+	return &ir.FormField{Name: f.Name, Type: f.Type, Value: f.Value, Caption: f.Caption}
+}
+
+// parseForm parses a form object control starting at the CTRL_HEADER record.
+// Returns nil if the control is not a form object or holds no value (명령 단추 등).
+func (sp *SectionParser) parseForm(startIdx int, offset int) *FormObject {
+	ctrlRec := sp.records[startIdx]
+	if parseCtrlID(ctrlRec.Data) != CtrlForm {
+		return nil
+	}
+
+	end := sp.subtreeEnd(startIdx)
+	for i := startIdx + 1; i < end; i++ {
+		rec := sp.records[i]
+		if rec.TagID != TagCtrlFormField || rec.Level != ctrlRec.Level+1 {
+			continue
+		}
+		form := parseFormProperties(formPropertyString(rec.Data))
+		if form != nil {
+			form.Offset = offset
+		}
+		return form
+	}
+	return nil
+}
+
+// formPropertyString returns the property string of a FORM_OBJECT record.
+// 종류 ID(4) 뒤에 길이(2)가 오거나, 길이(4) 또는 예약(4)과 길이(2)가 오는 배치를 시도한다.
+func formPropertyString(data []byte) string {
+	candidates := []struct{ lenAt, lenSize int }{{4, 4}, {4, 2}, {8, 2}}
+	for _, c := range candidates {
+		start := c.lenAt + c.lenSize
+		if start > len(data) {
+			continue
+		}
+		n := int(binary.LittleEndian.Uint16(data[c.lenAt : c.lenAt+2]))
+		if c.lenSize == 4 && binary.LittleEndian.Uint16(data[c.lenAt+2:c.lenAt+4]) != 0 {
+			continue
+		}
+		if n == 0 || start+n*2 > len(data) {
+			continue
+		}
+		if s := DecodeUTF16LE(data[start : start+n*2]); strings.Contains(s, "Name:") {
+			return s
+		}
+	}
+	return ""
+}
+
+// parseFormProperties builds a form object from its property string.
+// Returns nil for objects without a value (명령 단추, 스크롤 막대).
+func parseFormProperties(props string) *FormObject {
+	kind, _, _ := strings.Cut(props, " ")
+	form := &FormObject{
+		Name:    parameterValue(props, "Name"),
+		Caption: parameterValue(props, "Caption"),
+	}
+
+	switch strings.ToLower(kind) {
+	case "checkbtn", "checkbutton":
+		form.Type = ir.FormFieldCheckBox
+		form.Value = formChecked(parameterValue(props, "Value"))
+	case "radiobtn", "radiobutton":
+		form.Type = ir.FormFieldRadio
+		form.Value = formChecked(parameterValue(props, "Value"))
+	case "edit":
+		form.Type = ir.FormFieldText
+		form.Value = parameterValue(props, "Text")
+	case "combobox":
+		form.Type = ir.FormFieldComboBox
+		form.Value = parameterValue(props, "Text")
+	case "listbox":
+		form.Type = ir.FormFieldListBox
+		form.Value = parameterValue(props, "Text")
+	default:
+		return nil
+	}
+	return form
+}
+
+// formChecked converts the value of a check box or radio button (0: 해제, 1: 선택, 2: 미정) to "true"/"false".
+func formChecked(value string) string {
+	if value == "1" {
+		return "true"
+	}
+	return "false"
+}
@@ -0,0 +1,141 @@
+package hwp5
+
+import (
+	"encoding/binary"
+	"sort"
+)
+
+// 구역 정의 (secd), 용지 설정 (PAGE_DEF), 단 정의 (cold)와 문단 레이아웃 (PARA_LINE_SEG)
+// 참조: HWP 5.0 명세서 4.3.10.1 구역 정의, 4.3.10.1.1 용지 설정, 4.3.10.2 단 정의, 4.3.5 문단의 레이아웃
+
+// 문단 나누기 종류 (PARA_HEADER)
+const (
+	DivisionSection     uint8 = 0x01 // 구역 나누기
+	DivisionMultiColumn uint8 = 0x02 // 다단 나누기
+	DivisionPage        uint8 = 0x04 // 쪽 나누기
+	DivisionColumn      uint8 = 0x08 // 단 나누기
+)
+
+// PageDef는 구역의 용지 설정 (HWPUNIT)
+type PageDef struct {
+	Width        int32
+	Height       int32
+	MarginLeft   int32
+	MarginRight  int32
+	MarginTop    int32
+	MarginBottom int32
+	MarginHeader int32
+	MarginFooter int32
+	Gutter       int32
+	Attributes   uint32 // bit 0: 용지 방향 (1이면 가로), bit 1-2: 제책 방법
+}
+
+// IsLandscape returns true if the paper is turned sideways.
+func (d *PageDef) IsLandscape() bool {
+	return d.Attributes&0x01 != 0
+}
+
+// parsePageDef parses a PAGE_DEF record.
+func parsePageDef(data []byte) *PageDef {
+	if len(data) < 40 {
+		return nil
+	}
+	v := func(offset int) int32 {
+		return int32(binary.LittleEndian.Uint32(data[offset : offset+4]))
+	}
+	return &PageDef{
+		Width:        v(0),
+		Height:       v(4),
+		MarginLeft:   v(8),
+		MarginRight:  v(12),
+		MarginTop:    v(16),
+		MarginBottom: v(20),
+		MarginHeader: v(24),
+		MarginFooter: v(28),
+		Gutter:       v(32),
+		Attributes:   binary.LittleEndian.Uint32(data[36:40]),
+	}
+}
+
+// ColumnDef는 단 정의
+type ColumnDef struct {
+	Count int   // 단 개수
+	Gap   int32 // 단 사이 간격 (HWPUNIT)
+	Block int   // 단 정의가 적용되는 첫 블록 (구역 블록 목록의 인덱스)
+}
+
+// parseColumnDef parses the CTRL_HEADER data of a column definition control.
+// 속성(2): bit 0-1 종류, bit 2-9 단 개수, bit 10-11 방향, bit 12 너비 동일 / 단 사이 간격(2) / ...
+func parseColumnDef(data []byte) *ColumnDef {
+	if len(data) < 8 {
+		return nil
+	}
+	attr := binary.LittleEndian.Uint16(data[4:6])
+	return &ColumnDef{
+		Count: max(int(attr>>2&0xFF), 1),
+		Gap:   int32(binary.LittleEndian.Uint16(data[6:8])),
+	}
+}
+
+// parseLayoutControl records a section definition (용지 설정) or a column definition.
+// Master pages under a section definition are collected with the headers and footers.
+// Returns true if the control at startIdx is one of them.
+func (sp *SectionParser) parseLayoutControl(startIdx int) bool {
+	ctrlRec := sp.records[startIdx]
+	switch parseCtrlID(ctrlRec.Data) {
+	case CtrlSection:
+		end := sp.subtreeEnd(startIdx)
+		for i := startIdx + 1; i < end; i++ {
+			if rec := sp.records[i]; rec.TagID == TagPageDef && rec.Level == ctrlRec.Level+1 {
+				sp.pageDef = parsePageDef(rec.Data)
+				break
+			}
+		}
+		sp.parseHeaderFooter(startIdx)
+		return true
+
+	case CtrlColumn:
+		if def := parseColumnDef(ctrlRec.Data); def != nil {
+			sp.columnDefs = append(sp.columnDefs, def)
+		}
+		return true
+	}
+	return false
+}
+
+// LineSeg는 문단 한 줄의 배치 정보
+type LineSeg struct {
+	Offset  int    // 줄이 시작하는 텍스트 위치 (문단 텍스트의 룬 단위)
+	VertPos int32  // 단 위쪽에서 줄까지의 세로 위치 (HWPUNIT)
+	Flags   uint32 // bit 0: 쪽의 첫 줄, bit 1: 단의 첫 줄
+}
+
+// IsPageStart returns true if the line is flagged as the first line of a page.
+func (l LineSeg) IsPageStart() bool {
+	return l.Flags&0x01 != 0
+}
+
+// parseLineSegs parses a PARA_LINE_SEG record.
+// 줄마다 36바이트: 텍스트 시작 위치(4), 세로 위치(4), 높이(4), 텍스트 높이(4), 기준선(4),
+// 줄 간격(4), 가로 시작 위치(4), 너비(4), 태그(4). 텍스트 시작 위치는 PARA_TEXT의 WCHAR 단위다.
+func parseLineSegs(data []byte) []LineSeg {
+	var segs []LineSeg
+	for offset := 0; offset+36 <= len(data); offset += 36 {
+		segs = append(segs, LineSeg{
+			Offset:  int(binary.LittleEndian.Uint32(data[offset : offset+4])),
+			VertPos: int32(binary.LittleEndian.Uint32(data[offset+4 : offset+8])),
+			Flags:   binary.LittleEndian.Uint32(data[offset+32 : offset+36]),
+		})
+	}
+	return segs
+}
+
+// resolveLineOffsets converts the WCHAR start positions of the line segments to rune offsets.
+func (p *Paragraph) resolveLineOffsets() {
+	for i := range p.LineSegs {
+		start := p.LineSegs[i].Offset
+		p.LineSegs[i].Offset = sort.Search(len(p.charPositions), func(r int) bool {
+			return p.charPositions[r] >= start
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package hwp5
+
+import (
+	"github.com/roboco-io/hwp2md/internal/parser"
+)
+
+// levelStart returns the start number of a numbering level.
+func levelStart(numbering *Numbering, level int) int {
+	if numbering == nil {
+		return 1
+	}
+	if start := numbering.Levels[level].Start; start > 0 {
+		return int(start)
+	}
+	if level == 0 && numbering.Start > 0 {
+		return int(numbering.Start)
+	}
+	return 1
+}
+
+// listBuilder groups consecutive list paragraphs into nested list blocks.
+type listBuilder struct {
+	parser.ListBuilder
+
+	// 목록이 시작하는 쪽과 쪽 나누기 여부
+	page      int
+	pageBreak bool
+}
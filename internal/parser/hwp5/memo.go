@@ -0,0 +1,35 @@
+package hwp5
+
+import "encoding/binary"
+
+// 메모 (MEMO_LIST)
+// 참조: HWP 5.0 명세서 필드 시작 (메모 필드 "%%me"), HWPTAG_MEMO_LIST
+//
+// 본문에는 메모 필드가 메모를 붙인 텍스트 구간을 표시하고, 메모 내용은 구역 끝의
+// MEMO_LIST 레코드 뒤에 LIST_HEADER와 문단 목록으로 저장된다. 메모 필드와 메모 내용은
+// 구역 안에서 같은 순서로 나타난다.
+
+// Memo는 메모 내용
+type Memo struct {
+	Index      uint32 // 메모 번호
+	Paragraphs []*Paragraph
+}
+
+// parseMemo parses the memo body that follows a MEMO_LIST record.
+// Returns the memo and the next record index.
+func (sp *SectionParser) parseMemo(startIdx int) (*Memo, int) {
+	rec := sp.records[startIdx]
+	memo := &Memo{}
+	if len(rec.Data) >= 4 {
+		memo.Index = binary.LittleEndian.Uint32(rec.Data[0:4])
+	}
+
+	// LIST_HEADER는 MEMO_LIST의 하위 레코드이거나 바로 뒤의 형제 레코드다
+	i := startIdx + 1
+	if i < len(sp.records) && sp.records[i].TagID == TagListHeader && sp.records[i].Level >= rec.Level {
+		paragraphs, nextIdx := sp.parseParagraphList(i)
+		memo.Paragraphs = paragraphs
+		i = max(nextIdx, i+1)
+	}
+	return memo, i
+}
@@ -8,9 +8,14 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
+	"time"
+	"unicode"
 
 	"github.com/richardlehane/mscfb"
+	"github.com/roboco-io/hwp2md/internal/chart"
+	"github.com/roboco-io/hwp2md/internal/equation"
 	"github.com/roboco-io/hwp2md/internal/ir"
+	"github.com/roboco-io/hwp2md/internal/ole"
 	"github.com/roboco-io/hwp2md/internal/parser"
 )
 
@@ -26,6 +31,20 @@ type Parser struct {
 	docInfo    *DocInfo
 	sections   []string // Section stream names
 	binDataDir string   // BinData storage path
+
+	// 문단 번호/글머리표 상태
+	numbering parser.ListCounters
+	lists     listBuilder
+
+	// 현재 구역의 메모 내용과 이미 등록한 메모 필드의 참조
+	memos    []*Memo
+	memoRefs map[*Field]ir.Run
+
+	// 줄 배치로 추정한 현재 쪽
+	pages parser.PageCounter
+
+	// 캡션의 그림/표/수식 번호 (구역이 바뀌어도 이어진다)
+	numbers *parser.AutoNumbers
 }
 
 // New creates a new HWP5 parser for the given file path.
@@ -78,6 +97,7 @@ func (p *Parser) Parse() (*ir.Document, error) {
 			return nil, fmt.Errorf("섹션 %s 파싱 실패: %w", sectionName, err)
 		}
 	}
+	doc.FlushAnchors()
 
 	return doc, nil
 }
@@ -118,11 +138,21 @@ func (p *Parser) parseFileHeader() error {
 }
 
 // findSections finds all Section streams in BodyText storage.
+// Distributable documents keep their body in ViewText storage instead.
 func (p *Parser) findSections() {
+	// 배포용 문서는 ViewText/SectionN, 일반 문서는 BodyText/SectionN
+	skipStorage := StreamViewText
+	if p.header != nil && p.header.IsDistributable() {
+		skipStorage = StreamBodyText
+	}
+
 	for _, entry := range p.doc.File {
 		name := entry.Name
 		path := entry.Path
 		fullPath := strings.Join(path, "/")
+		if strings.HasPrefix(fullPath, skipStorage) {
+			continue
+		}
 
 		// BodyText/SectionN 형식 찾기
 		if strings.HasPrefix(fullPath, StreamBodyText) || strings.HasPrefix(name, "Section") {
@@ -187,6 +217,15 @@ func (p *Parser) parseSection(doc *ir.Document, sectionPath string) error {
 		return err
 	}
 
+	// 배포용 문서 복호화
+	if strings.HasPrefix(sectionPath, StreamViewText) {
+		decrypted, err := DecryptViewText(data)
+		if err != nil {
+			return fmt.Errorf("배포용 문서 복호화 실패: %w", err)
+		}
+		data = decrypted
+	}
+
 	// 압축 해제 (필요시)
 	if p.header.IsCompressed() {
 		decompressed, err := DecompressStream(data)
@@ -197,6 +236,10 @@ func (p *Parser) parseSection(doc *ir.Document, sectionPath string) error {
 	}
 
 	sectionParser := NewSectionParser(p.docInfo)
+	if p.numbers == nil {
+		p.numbers = p.docInfo.AutoNumbers()
+	}
+	sectionParser.numbers = p.numbers
 	section, err := sectionParser.Parse(data)
 	if err != nil {
 		return err
@@ -208,88 +251,679 @@ func (p *Parser) parseSection(doc *ir.Document, sectionPath string) error {
 	return nil
 }
 
-// convertSectionToIR converts a parsed section to IR blocks.
+// convertSectionToIR converts a parsed section to IR blocks in reading order.
+// 연속된 번호/글머리표 문단은 하나의 (중첩) 목록으로 묶는다.
 func (p *Parser) convertSectionToIR(doc *ir.Document, section *Section) {
-	// 문단 변환
-	for _, para := range section.Paragraphs {
-		if para.Text == "" {
+	p.memos = section.Memos
+	p.memoRefs = make(map[*Field]ir.Run)
+
+	columnDefs := section.ColumnDefs
+	columns := 1
+	if len(columnDefs) > 0 && columnDefs[0].Block == 0 {
+		columns = columnDefs[0].Count
+	}
+	p.pages.StartSection(columns)
+	if section.PageDef != nil {
+		doc.AddSection(newSection(section.PageDef))
+	}
+
+	for i, block := range section.Blocks {
+		for len(columnDefs) > 0 && columnDefs[0].Block <= i {
+			doc.SetColumns(columnDefs[0].Count, parser.HWPUnitToMM(columnDefs[0].Gap))
+			p.pages.SetColumns(columnDefs[0].Count)
+			columnDefs = columnDefs[1:]
+		}
+
+		// 블록의 첫 줄로 시작 쪽을 정하고, 나머지 줄은 변환 뒤에 센다
+		lines := block.LineSegs
+		if len(lines) > 0 {
+			p.pages.Line(lines[0].VertPos, lines[0].IsPageStart() || block.PageBreak)
+			lines = lines[1:]
+		}
+		doc.SetPage(p.pages.Page())
+
+		if block.Type == BlockParagraph && p.convertListParagraph(doc, block.Paragraph, block.PageBreak) {
+			// 목록 항목의 책갈피는 목록 블록에 붙는다
+			addBookmarks(doc, block.Paragraph)
+			p.countLines(lines)
 			continue
 		}
+		p.flushList(doc)
+		if block.PageBreak {
+			doc.AddPageBreak()
+		}
+
+		switch block.Type {
+		case BlockParagraph:
+			p.convertParagraph(doc, block.Paragraph)
+		case BlockTable:
+			p.convertTable(doc, block.Table)
+		case BlockImage:
+			// 이미지 변환 (옵션이 활성화된 경우)
+			if p.options.ExtractImages {
+				p.convertImage(doc, block.Image)
+			}
+		case BlockTextBox:
+			for _, para := range block.TextBox.Paragraphs {
+				p.addFormFields(doc, para, nil)
+			}
+			doc.AddTextBox(ir.NewTextBox(p.convertParagraphList(doc, block.TextBox.Paragraphs)))
+		case BlockOLE:
+			p.convertOLE(doc, block.OLE)
+		}
+		p.countLines(lines)
+	}
+	p.flushList(doc)
+
+	for _, hf := range section.HeaderFooters {
+		p.convertHeaderFooter(doc, hf)
+	}
+}
+
+// newSection converts a page definition to an IR section.
+func newSection(def *PageDef) *ir.Section {
+	s := &ir.Section{
+		Width:       parser.HWPUnitToMM(def.Width),
+		Height:      parser.HWPUnitToMM(def.Height),
+		Orientation: ir.OrientationPortrait,
+		Margins: ir.Margins{
+			Top:    parser.HWPUnitToMM(def.MarginTop),
+			Bottom: parser.HWPUnitToMM(def.MarginBottom),
+			Left:   parser.HWPUnitToMM(def.MarginLeft),
+			Right:  parser.HWPUnitToMM(def.MarginRight),
+			Header: parser.HWPUnitToMM(def.MarginHeader),
+			Footer: parser.HWPUnitToMM(def.MarginFooter),
+		},
+	}
+	if def.IsLandscape() {
+		// 용지 크기는 세로 방향 기준으로 저장되므로 가로 방향이면 바꾼다
+		s.Orientation = ir.OrientationLandscape
+		if s.Width < s.Height {
+			s.Width, s.Height = s.Height, s.Width
+		}
+	}
+	return s
+}
+
+// countLines advances the page counter over the remaining lines of a block.
+func (p *Parser) countLines(lines []LineSeg) {
+	for _, line := range lines {
+		p.pages.Line(line.VertPos, line.IsPageStart())
+	}
+}
+
+// convertHeaderFooter adds header/footer/master page text to the document.
+// 같은 내용은 구역마다 반복되어도 한 번만 저장된다.
+func (p *Parser) convertHeaderFooter(doc *ir.Document, hf *HeaderFooter) {
+	paragraphs := p.convertParagraphList(doc, hf.Paragraphs)
+
+	scope := ir.PageScopeBoth
+	switch hf.Apply {
+	case ApplyEvenPages:
+		scope = ir.PageScopeEven
+	case ApplyOddPages:
+		scope = ir.PageScopeOdd
+	}
+
+	switch hf.Kind {
+	case KindHeader:
+		doc.AddHeader(scope, paragraphs)
+	case KindFooter:
+		doc.AddFooter(scope, paragraphs)
+	case KindMasterPage:
+		doc.AddMasterPage(scope, paragraphs)
+	}
+}
+
+// convertParagraph converts a paragraph to an IR paragraph block.
+// 수식만 있는 문단은 별도 줄의 수식 블록이 된다.
+func (p *Parser) convertParagraph(doc *ir.Document, para *Paragraph) {
+	if para == nil {
+		return
+	}
+
+	// 책갈피만 있는 빈 문단이면 다음 블록에 붙는다
+	addBookmarks(doc, para)
+	p.addFormFields(doc, para, nil)
+	if para.Text == "" && len(para.Equations) == 0 && len(para.Forms) == 0 {
+		return
+	}
+
+	if strings.TrimSpace(para.Text) == "" && len(para.Equations) == 1 && len(para.Forms) == 0 {
+		script := para.Equations[0].Script
+		if tex := equation.ToLaTeX(script); tex != "" {
+			doc.AddMath(ir.NewMath(tex, script))
+		}
+		return
+	}
+
+	irPara := ir.NewParagraph(strings.TrimSpace(para.Text))
+	irPara.Runs = p.convertRuns(doc, para.TextRuns())
+	if len(para.Equations) > 0 || len(para.Forms) > 0 || irPara.HasAnnotations() {
+		irPara.Text = ir.RunsText(irPara.Runs)
+	}
+	if level := p.headingLevel(para); level > 0 {
+		irPara.SetHeading(level)
+	}
+	doc.AddParagraph(irPara)
+}
+
+// addBookmarks registers the bookmarks of a paragraph as anchors of the next document block.
+func addBookmarks(doc *ir.Document, para *Paragraph) {
+	for _, bm := range para.Bookmarks {
+		doc.AddAnchor(bm.Name)
+	}
+}
+
+// addFormFields records the click-here fields and form objects of a paragraph in reading order.
+// cell is set for a paragraph in a table cell.
+func (p *Parser) addFormFields(doc *ir.Document, para *Paragraph, cell *ir.CellRef) {
+	type located struct {
+		offset int
+		field  *ir.FormField
+	}
+	var fields []located
+	for _, f := range para.Fields {
+		if f.IsClickHere() {
+			fields = append(fields, located{f.Start, &ir.FormField{
+				Name:  f.Name,
+				Type:  ir.FormFieldClickHere,
+				Value: f.text(para),
+				Hint:  f.Parameter("Direction"),
+			}})
+		}
+	}
+	for _, form := range para.Forms {
+		offset := form.Offset
+		if offset < 0 {
+			offset = len([]rune(para.Text))
+		}
+		fields = append(fields, located{offset, form.Field()})
+	}
+	sort.SliceStable(fields, func(a, b int) bool { return fields[a].offset < fields[b].offset })
+
+	for _, f := range fields {
+		if cell != nil {
+			ref := *cell
+			f.field.Cell = &ref
+		}
+		doc.AddField(f.field)
+	}
+}
+
+// headingLevel returns the heading level of a paragraph, or 0 for body text.
+// 문단 모양의 개요 수준을 우선 사용하고, 없으면 스타일 이름으로 판단한다.
+func (p *Parser) headingLevel(para *Paragraph) int {
+	if p.docInfo == nil {
+		return 0
+	}
+
+	if int(para.ParaShapeID) < len(p.docInfo.ParaShapes) {
+		if level := p.docInfo.ParaShapes[para.ParaShapeID].OutlineLevel(); level > 0 {
+			return level
+		}
+	}
+
+	if int(para.StyleID) < len(p.docInfo.Styles) {
+		return p.docInfo.Styles[para.StyleID].HeadingLevel()
+	}
+
+	return 0
+}
+
+// convertListParagraph adds a numbered or bulleted paragraph to the pending list.
+// Returns false if the paragraph is not a list item.
+// pageBreak is set if the paragraph follows an explicit page break.
+func (p *Parser) convertListParagraph(doc *ir.Document, para *Paragraph, pageBreak bool) bool {
+	if para == nil {
+		return false
+	}
+
+	head, ok := p.listHead(para)
+	if !ok {
+		return false
+	}
+
+	text := strings.TrimSpace(para.Text)
+	if text == "" && len(para.Forms) == 0 {
+		p.addFormFields(doc, para, nil)
+		return true
+	}
+
+	if head.Ordered {
+		var numbering *Numbering
+		if head.ID <= len(p.docInfo.Numberings) {
+			numbering = p.docInfo.Numberings[head.ID-1]
+		}
+		if p.numbering == nil {
+			p.numbering = make(parser.ListCounters)
+		}
+		head.Number = p.numbering.Next(head.ID, head.Level, levelStart(numbering, head.Level))
+	}
 
-		irPara := ir.NewParagraph(strings.TrimSpace(para.Text))
-		doc.AddParagraph(irPara)
+	item := ir.ListItem{Text: text, Runs: p.convertRuns(doc, para.TextRuns())}
+	if len(para.Forms) > 0 {
+		item.Text = ir.RunsText(item.Runs)
+	}
+	page, brk := p.lists.page, p.lists.pageBreak
+	root := p.lists.Root()
+	if done := p.lists.Add(head, item); done != nil {
+		p.addList(doc, done, page, brk)
+	}
+	if p.lists.Root() != root {
+		p.lists.page = p.pages.Page()
+		p.lists.pageBreak = pageBreak
+	}
+	// 앞 목록이 문서에 추가된 뒤에 기록해야 누름틀이 이 항목을 담을 목록 블록을 가리킨다.
+	p.addFormFields(doc, para, nil)
+	return true
+}
+
+// listHead returns the list head information of a paragraph.
+// 문단 모양의 머리 모양이 번호 또는 글머리표인 문단만 목록 항목이 된다.
+func (p *Parser) listHead(para *Paragraph) (parser.ListHead, bool) {
+	if p.docInfo == nil || int(para.ParaShapeID) >= len(p.docInfo.ParaShapes) {
+		return parser.ListHead{}, false
+	}
+
+	ps := p.docInfo.ParaShapes[para.ParaShapeID]
+	if ps.NumberingID == 0 {
+		return parser.ListHead{}, false
 	}
 
-	// 표 변환
-	for _, table := range section.Tables {
-		if table == nil || table.Rows == 0 || table.Cols == 0 {
+	switch ps.HeadType() {
+	case ParaHeadNumbering:
+		return parser.ListHead{Ordered: true, ID: int(ps.NumberingID), Level: ps.Level()}, true
+	case ParaHeadBullet:
+		return parser.ListHead{Ordered: false, ID: int(ps.NumberingID), Level: ps.Level()}, true
+	}
+
+	return parser.ListHead{}, false
+}
+
+// flushList adds the pending list, if any, to the document.
+func (p *Parser) flushList(doc *ir.Document) {
+	page, brk := p.lists.page, p.lists.pageBreak
+	if list := p.lists.Finish(); list != nil {
+		p.addList(doc, list, page, brk)
+	}
+}
+
+// addList adds a finished list on the page its first item started on.
+func (p *Parser) addList(doc *ir.Document, list *ir.ListBlock, page int, pageBreak bool) {
+	if pageBreak {
+		doc.AddPageBreak()
+	}
+	doc.SetPage(page)
+	doc.AddList(list)
+	doc.SetPage(p.pages.Page())
+}
+
+// convertRuns converts char-shape runs to IR runs.
+// 앞뒤 공백은 문단 텍스트와 맞추기 위해 잘라내고, 스타일이 같은 인접 구간은 합친다.
+// 각주/미주 참조는 문서에 본문을 등록한 뒤 참조 구간으로 바꾼다.
+// 수식은 LaTeX로 바꾼 인라인 수식 구간이 된다.
+// 메모는 문서에 내용을 등록하고, 메모를 붙인 구간 끝에 메모 참조 구간을 둔다.
+func (p *Parser) convertRuns(doc *ir.Document, runs []TextRun) []ir.Run {
+	var irRuns []ir.Run
+	for _, run := range runs {
+		if run.Note != nil {
+			irRuns = append(irRuns, p.convertNote(doc, run.Note))
+			continue
+		}
+		if run.Equation != nil {
+			if tex := equation.ToLaTeX(run.Equation.Script); tex != "" {
+				irRuns = append(irRuns, ir.Run{Text: tex, Style: ir.TextStyle{Math: true}})
+			}
+			continue
+		}
+		if run.Form != nil {
+			// 양식 개체는 값을 글자로 넣는다
+			if text := run.Form.Field().InlineText(); text != "" {
+				irRuns = append(irRuns, ir.Run{Text: text})
+			}
+			continue
+		}
+		if run.MemoEnd != nil {
+			irRuns = append(irRuns, p.memoComment(doc, run.MemoEnd))
+			continue
+		}
+
+		style := p.textStyle(run.CharShapeID)
+		style.Link = run.Link
+		style.Change = ir.ChangeType(run.Change)
+		if run.Change != "" {
+			style.ChangeAuthor = p.docInfo.ChangeAuthor(run.ChangeID)
+		}
+		if run.Memo != nil {
+			style.Comment = p.memoComment(doc, run.Memo).Style.CommentRef
+		}
+		if n := len(irRuns); n > 0 && irRuns[n-1].Style == style {
+			irRuns[n-1].Text += run.Text
 			continue
 		}
+		irRuns = append(irRuns, ir.Run{Text: run.Text, Style: style})
+	}
 
-		irTable := ir.NewTable(table.Rows, table.Cols)
+	// 앞쪽 공백 제거 (각주 참조, 수식에서 멈춤)
+	for len(irRuns) > 0 && !isInlineObject(irRuns[0]) {
+		irRuns[0].Text = strings.TrimLeftFunc(irRuns[0].Text, unicode.IsSpace)
+		if irRuns[0].Text != "" {
+			break
+		}
+		irRuns = irRuns[1:]
+	}
 
-		for rowIdx, row := range table.Cells {
-			for colIdx, cell := range row {
-				if cell == nil {
-					continue
-				}
+	// 뒤쪽 공백 제거
+	for len(irRuns) > 0 && !isInlineObject(irRuns[len(irRuns)-1]) {
+		last := len(irRuns) - 1
+		irRuns[last].Text = strings.TrimRightFunc(irRuns[last].Text, unicode.IsSpace)
+		if irRuns[last].Text != "" {
+			break
+		}
+		irRuns = irRuns[:last]
+	}
 
-				// 셀 텍스트 추출
-				var cellText strings.Builder
-				for i, para := range cell.Paragraphs {
-					if i > 0 {
-						cellText.WriteString("\n")
-					}
-					cellText.WriteString(para.Text)
-				}
+	return irRuns
+}
 
-				if rowIdx < len(irTable.Cells) && colIdx < len(irTable.Cells[rowIdx]) {
-					irTable.Cells[rowIdx][colIdx].Text = strings.TrimSpace(cellText.String())
-					irTable.Cells[rowIdx][colIdx].RowSpan = cell.RowSpan
-					irTable.Cells[rowIdx][colIdx].ColSpan = cell.ColSpan
-				}
+// isInlineObject reports whether the run is a footnote reference, an equation or a comment rather than text.
+func isInlineObject(run ir.Run) bool {
+	return run.Style.FootnoteRef != "" || run.Style.Math || run.Style.CommentRef != ""
+}
+
+// memoComment registers the comment of a memo field and returns its reference run.
+// 메모 내용은 구역의 메모 필드 순서대로 대응시키며, 같은 필드는 한 번만 등록한다.
+func (p *Parser) memoComment(doc *ir.Document, field *Field) ir.Run {
+	if ref, ok := p.memoRefs[field]; ok {
+		return ref
+	}
+
+	var paragraphs []*ir.Paragraph
+	if len(p.memos) > 0 {
+		paragraphs = p.convertParagraphList(doc, p.memos[0].Paragraphs)
+		p.memos = p.memos[1:]
+	}
+	ref := doc.AddComment(field.Parameter("Author"), field.Parameter("CreateDateTime"), paragraphs)
+	if p.memoRefs != nil {
+		p.memoRefs[field] = ref
+	}
+	return ref
+}
+
+// convertNote registers a footnote/endnote body in the document and returns its reference run.
+func (p *Parser) convertNote(doc *ir.Document, note *Note) ir.Run {
+	return doc.AddFootnote(note.Endnote, p.convertParagraphList(doc, note.Paragraphs))
+}
+
+// convertParagraphList converts the non-empty paragraphs of a sub-list (note, header, ...) to IR paragraphs.
+func (p *Parser) convertParagraphList(doc *ir.Document, paras []*Paragraph) []*ir.Paragraph {
+	var paragraphs []*ir.Paragraph
+	for _, para := range paras {
+		addBookmarks(doc, para)
+		text := strings.TrimSpace(para.Text)
+		if text == "" && len(para.Equations) == 0 && len(para.Forms) == 0 {
+			continue
+		}
+		irPara := ir.NewParagraph(text)
+		irPara.Runs = p.convertRuns(doc, para.TextRuns())
+		if len(para.Equations) > 0 || len(para.Forms) > 0 || irPara.HasAnnotations() {
+			irPara.Text = ir.RunsText(irPara.Runs)
+		}
+		paragraphs = append(paragraphs, irPara)
+	}
+	return paragraphs
+}
+
+// cellParagraphText returns the text of a table cell paragraph.
+// 셀은 문자열만 담으므로 각주 참조([^1]), 하이퍼링크([텍스트](URL)), 수식($...$)은
+// Markdown 표기로, 교정 부호와 메모는 CriticMarkup 표기로 텍스트에 넣는다.
+func (p *Parser) cellParagraphText(doc *ir.Document, para *Paragraph) string {
+	if len(para.Notes) == 0 && len(para.Fields) == 0 && len(para.Equations) == 0 && len(para.Forms) == 0 {
+		return para.Text
+	}
+
+	var sb strings.Builder
+	var memo *Field // 열려 있는 메모 구간
+	for _, run := range para.TextRuns() {
+		if run.Memo != memo && run.MemoEnd == nil {
+			if memo != nil {
+				sb.WriteString("==}")
 			}
+			if run.Memo != nil {
+				sb.WriteString("{==")
+			}
+			memo = run.Memo
 		}
 
-		// 첫 행을 헤더로 설정
-		if table.Rows > 1 {
-			irTable.SetHeaderRow()
+		switch {
+		case run.Note != nil:
+			ref := p.convertNote(doc, run.Note)
+			sb.WriteString("[^" + ref.Style.FootnoteRef + "]")
+		case run.Equation != nil:
+			if tex := equation.ToLaTeX(run.Equation.Script); tex != "" {
+				sb.WriteString("$" + tex + "$")
+			}
+		case run.Form != nil:
+			sb.WriteString(run.Form.Field().InlineText())
+		case run.MemoEnd != nil:
+			if memo != nil {
+				sb.WriteString("==}")
+				memo = nil
+			}
+			sb.WriteString("{>>" + p.memoComment(doc, run.MemoEnd).Text + "<<}")
+		case run.Link != "" && strings.TrimSpace(run.Text) != "":
+			core := strings.TrimSpace(run.Text)
+			lead := run.Text[:strings.Index(run.Text, core)]
+			trail := run.Text[len(lead)+len(core):]
+			sb.WriteString(ir.CriticChange(ir.ChangeType(run.Change), lead+"["+core+"]("+run.Link+")"+trail))
+		default:
+			sb.WriteString(ir.CriticChange(ir.ChangeType(run.Change), run.Text))
 		}
+	}
+	if memo != nil {
+		sb.WriteString("==}")
+	}
+	return sb.String()
+}
+
+// textStyle converts a char shape to IR text style hints.
+func (p *Parser) textStyle(charShapeID uint32) ir.TextStyle {
+	var style ir.TextStyle
+	if p.docInfo == nil || int(charShapeID) >= len(p.docInfo.CharShapes) {
+		return style
+	}
 
-		doc.AddTable(irTable)
+	cs := p.docInfo.CharShapes[charShapeID]
+	style.Bold = cs.IsBold()
+	style.Italic = cs.IsItalic()
+	style.Underline = cs.IsUnderline()
+	style.Strikethrough = cs.IsStrikeout()
+	style.Superscript = cs.IsSuperscript()
+	style.Subscript = cs.IsSubscript()
+	if cs.HasShade() {
+		style.Highlight = cs.ShadeColorHex()
 	}
 
-	// 이미지 변환 (옵션이 활성화된 경우)
-	if p.options.ExtractImages {
-		for _, img := range section.Images {
-			irImg := ir.NewImage("")
-			irImg.ID = fmt.Sprintf("BIN%04X", img.BinDataID)
-			irImg.Width = int(img.Width / 7200) // EMU to pixels (approximate)
-			irImg.Height = int(img.Height / 7200)
+	return style
+}
+
+// convertTable converts a table to an IR table block.
+func (p *Parser) convertTable(doc *ir.Document, table *Table) {
+	if table == nil || table.Rows == 0 || table.Cols == 0 {
+		return
+	}
+
+	doc.AddTable(p.buildTable(doc, table))
+
+	// 셀 안의 OLE 개체는 (중첩 표의 것 포함) 표 바로 뒤에 배치
+	for _, object := range tableOLEs(table) {
+		p.convertOLE(doc, object)
+	}
+}
 
-			// BinData에서 이미지 데이터 추출
-			if p.docInfo != nil {
-				for _, binData := range p.docInfo.BinDataList {
-					if binData.BinDataID == img.BinDataID {
-						irImg.Path = binData.GetBinDataPath()
-						irImg.Format = strings.ToLower(binData.Extension)
+// buildTable converts a table to an IR table. Nested tables become child tables of their cell,
+// and pictures stay in their cell as cell images written in Markdown after their paragraph.
+func (p *Parser) buildTable(doc *ir.Document, table *Table) *ir.TableBlock {
+	irTable := ir.NewTable(table.Rows, table.Cols)
+	irTable.Caption = table.Caption
 
-						// 실제 이미지 데이터 추출
-						if p.options.ImageDir != "" {
-							p.extractImage(irImg, binData)
-						}
-						break
+	for rowIdx, row := range table.Cells {
+		for colIdx, cell := range row {
+			if cell == nil || rowIdx >= len(irTable.Cells) || colIdx >= len(irTable.Cells[rowIdx]) {
+				continue
+			}
+
+			irCell := &irTable.Cells[rowIdx][colIdx]
+			irCell.RowSpan = cell.RowSpan
+			irCell.ColSpan = cell.ColSpan
+			irCell.Style = p.cellStyle(cell)
+
+			// 셀 텍스트 추출 - 중첩 표는 놓인 위치에 평문으로 펼치고, 그림은 Markdown으로 둔다
+			var lines []string
+			nested, images := 0, 0
+			for i := 0; i <= len(cell.Paragraphs); i++ {
+				for nested < len(cell.Tables) && cell.tableAnchors[nested] <= i {
+					child := p.buildTable(doc, cell.Tables[nested])
+					irCell.Tables = append(irCell.Tables, child)
+					lines = append(lines, child.PlainText())
+					nested++
+				}
+				for ; images < len(cell.Images) && cell.imageAnchors[images] <= i; images++ {
+					if !p.options.ExtractImages {
+						continue
 					}
+					img := p.imageBlock(cell.Images[images])
+					irCell.Images = append(irCell.Images, img)
+					lines = append(lines, img.Markdown())
+				}
+				if i < len(cell.Paragraphs) {
+					addBookmarks(doc, cell.Paragraphs[i])
+					p.addFormFields(doc, cell.Paragraphs[i], &ir.CellRef{Row: rowIdx, Col: colIdx})
+					lines = append(lines, p.cellParagraphText(doc, cell.Paragraphs[i]))
 				}
 			}
+			irCell.Text = strings.TrimSpace(strings.Join(lines, "\n"))
+		}
+	}
+
+	// 배경색과 진한 글자로 제목 행/열을 찾는다
+	irTable.DetectHeaders()
+
+	return irTable
+}
+
+// cellStyle returns the background, alignment and boldness of a cell.
+// 정렬은 글자가 있는 첫 문단의 정렬을 따르고, 글자가 있는 문단의 글자 모양이 모두 진하면 진한 셀로 본다.
+func (p *Parser) cellStyle(cell *TableCell) ir.CellStyle {
+	var style ir.CellStyle
+	if p.docInfo == nil {
+		return style
+	}
+	if bf := p.docInfo.BorderFill(cell.BorderFill); bf != nil {
+		style.Background = bf.Background()
+	}
+
+	bold, plain := 0, 0
+	aligned := false
+	for _, para := range cell.Paragraphs {
+		if strings.TrimSpace(para.Text) == "" {
+			continue
+		}
+		if !aligned && int(para.ParaShapeID) < len(p.docInfo.ParaShapes) {
+			style.Alignment = p.docInfo.ParaShapes[para.ParaShapeID].Alignment()
+			aligned = true
+		}
+		for _, ref := range para.CharShapes {
+			if p.textStyle(ref.CharShapeID).Bold {
+				bold++
+			} else {
+				plain++
+			}
+		}
+	}
+	style.Bold = bold > 0 && plain == 0
+	return style
+}
 
-			doc.AddImage(irImg)
+// tableOLEs returns the OLE objects in the cells of a table and its nested tables.
+func tableOLEs(table *Table) []*OLEObject {
+	var objects []*OLEObject
+	for _, row := range table.Cells {
+		for _, cell := range row {
+			if cell == nil {
+				continue
+			}
+			objects = append(objects, cell.OLEs...)
+			for _, nested := range cell.Tables {
+				objects = append(objects, tableOLEs(nested)...)
+			}
 		}
 	}
+	return objects
+}
+
+// convertImage converts an image to an IR image block.
+func (p *Parser) convertImage(doc *ir.Document, img *Image) {
+	if img == nil {
+		return
+	}
+	doc.AddImage(p.imageBlock(img))
+}
+
+// imageBlock converts an image to an IR image, extracting its data when an image directory is set.
+func (p *Parser) imageBlock(img *Image) *ir.ImageBlock {
+	irImg := ir.NewImage(fmt.Sprintf("BIN%04X", img.BinDataID))
+	irImg.SetDimensions(hwpUnitToPixels(img.Width), hwpUnitToPixels(img.Height))
+	irImg.Alt = img.Description
+	irImg.Caption = img.Caption
+
+	// BinData에서 이미지 데이터 추출
+	if p.docInfo != nil {
+		for _, binData := range p.docInfo.BinDataList {
+			if binData.BinDataID == img.BinDataID {
+				irImg.Path = binData.GetBinDataPath()
+				irImg.Format = strings.ToLower(binData.Extension)
+
+				// 실제 이미지 데이터 추출
+				if p.options.ImageDir != "" {
+					p.extractImage(irImg, binData)
+				}
+				break
+			}
+		}
+	}
+
+	return irImg
 }
 
 // extractImage extracts image data from BinData storage.
 func (p *Parser) extractImage(irImg *ir.ImageBlock, binData *BinDataInfo) {
+	data, binPath, err := p.readBinData(binData)
+	if err != nil {
+		return
+	}
+
+	irImg.Data = data
+	irImg.OrigName = filepath.Base(binPath)
+	p.saveImage(irImg)
+}
+
+// saveImage writes the image data to the image directory, if one is set, and points Path to the file.
+func (p *Parser) saveImage(irImg *ir.ImageBlock) {
+	if p.options.ImageDir == "" {
+		return
+	}
+	outPath := filepath.Join(p.options.ImageDir, irImg.Path)
+	if err := os.MkdirAll(filepath.Dir(outPath), 0755); err == nil {
+		_ = os.WriteFile(outPath, irImg.Data, 0644)
+		irImg.Path = outPath
+	}
+}
+
+// readBinData reads and decompresses a BinData stream.
+// Returns the data and the stream path.
+func (p *Parser) readBinData(binData *BinDataInfo) ([]byte, string, error) {
 	binPath := fmt.Sprintf("BinData/BIN%04X.%s", binData.BinDataID, binData.Extension)
 
 	data, err := p.readStreamByPath(binPath)
@@ -298,25 +932,75 @@ func (p *Parser) extractImage(irImg *ir.ImageBlock, binData *BinDataInfo) {
 		binPath = fmt.Sprintf("BinData/BIN%04X", binData.BinDataID)
 		data, err = p.readStreamByPath(binPath)
 		if err != nil {
-			return
+			return nil, "", err
 		}
 	}
 
 	// 압축 해제 (BinData는 개별 압축될 수 있음)
-	if p.header.IsCompressed() {
+	if binData.IsCompressed(p.header.IsCompressed()) {
 		if decompressed, err := DecompressStream(data); err == nil {
 			data = decompressed
 		}
 	}
 
-	irImg.Data = data
+	return data, binPath, nil
+}
+
+// convertOLE converts an embedded OLE object.
+// 차트는 계열 값의 표가 되고, 삽입된 파일은 (옵션이 활성화된 경우) 첨부 파일로 추출된다.
+func (p *Parser) convertOLE(doc *ir.Document, object *OLEObject) {
+	if object == nil || p.docInfo == nil {
+		return
+	}
+
+	var binData *BinDataInfo
+	for _, info := range p.docInfo.BinDataList {
+		if info.BinDataID == object.BinDataID {
+			binData = info
+			break
+		}
+	}
+	if binData == nil {
+		return
+	}
+	data, _, err := p.readBinData(binData)
+	if err != nil {
+		return
+	}
+	payload, err := ole.Parse(data)
+	if err != nil {
+		return
+	}
+
+	id := fmt.Sprintf("BIN%04X", object.BinDataID)
+	caption := object.Caption
+	if caption == "" {
+		caption = object.Description
+	}
 
-	// 파일로 저장
-	if p.options.ImageDir != "" {
-		outPath := filepath.Join(p.options.ImageDir, irImg.Path)
-		if err := os.MkdirAll(filepath.Dir(outPath), 0755); err == nil {
-			_ = os.WriteFile(outPath, data, 0644)
-			irImg.Path = outPath
+	if p.options.ExtractAttachments {
+		attachment := payload.Attachment(id)
+		attachment.Caption = caption
+		if p.options.AttachmentDir != "" {
+			_ = attachment.Save(p.options.AttachmentDir)
+		}
+		doc.AddAttachment(attachment)
+	}
+
+	if payload.Chart != nil {
+		if c, err := chart.Parse(payload.Chart); err == nil {
+			table := c.Table()
+			table.Chart = id
+			if table.Caption == "" {
+				table.Caption = caption
+			}
+			// 문서에 표시되는 차트 그림을 표와 함께 둔다
+			if img := payload.PreviewImage(id); img != nil && p.options.ExtractImages {
+				img.Caption = caption
+				p.saveImage(img)
+				table.ChartImage = img
+			}
+			doc.AddTable(table)
 		}
 	}
 }
@@ -334,13 +1018,41 @@ func (p *Parser) buildMetadata() ir.Metadata {
 		meta.Creator = fmt.Sprintf("HWP %s", p.header.Version.String())
 	}
 
+	// 문서 요약 정보 (없거나 손상되면 무시)
+	data, err := p.readStream(StreamSummaryInfo)
+	if err != nil {
+		return meta
+	}
+	summary, err := ParseSummaryInfo(data)
+	if err != nil {
+		return meta
+	}
+
+	if summary.Title != "" {
+		meta.Title = summary.Title
+	}
+	meta.Author = summary.Author
+	meta.Subject = summary.Subject
+	meta.Keywords = summary.Keywords
+	meta.Description = summary.Comments
+	meta.LastModifiedBy = summary.LastSavedBy
+	if !summary.Created.IsZero() {
+		meta.Created = summary.Created.Format(time.RFC3339)
+	}
+	if !summary.Modified.IsZero() {
+		meta.Modified = summary.Modified.Format(time.RFC3339)
+	}
+	meta.PageCount = summary.PageCount
+
 	return meta
 }
 
 // readStream reads a stream by name from the root.
+// 이름 앞의 제어 문자 (\x05HwpSummaryInformation 등)는 무시하고 비교한다.
 func (p *Parser) readStream(name string) ([]byte, error) {
+	name = strings.TrimLeft(name, "\x05")
 	for _, entry := range p.doc.File {
-		if entry.Name == name {
+		if strings.TrimLeft(entry.Name, "\x05") == name {
 			return io.ReadAll(entry)
 		}
 	}
@@ -417,7 +1129,7 @@ func (p *Parser) ExtractImages(dir string) ([]ir.ImageBlock, error) {
 		}
 
 		// 압축 해제
-		if p.header.IsCompressed() {
+		if binData.IsCompressed(p.header.IsCompressed()) {
 			if decompressed, err := DecompressStream(data); err == nil {
 				data = decompressed
 			}
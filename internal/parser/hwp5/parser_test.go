@@ -1,7 +1,16 @@
 package hwp5
 
 import (
+	"bytes"
+	"crypto/aes"
+	"encoding/binary"
+	"fmt"
+	"sort"
 	"testing"
+	"time"
+
+	"github.com/roboco-io/hwp2md/internal/ir"
+	"github.com/roboco-io/hwp2md/internal/parser"
 )
 
 func TestParseFileHeader(t *testing.T) {
@@ -255,6 +264,113 @@ func TestDecompressStream(t *testing.T) {
 	}
 }
 
+func TestDecryptViewText(t *testing.T) {
+	key := []byte("0123456789abcdef")
+
+	// 시드 뒤 4 + (시드 & 0x0F) 위치에 키를 두고 XOR 마스크를 씌운다
+	docData := make([]byte, distributeDocDataSize)
+	binary.LittleEndian.PutUint32(docData[0:4], 0x12345673)
+	copy(docData[4+3:], key)
+	docData = decodeDistributeDocData(docData)
+
+	// 본문 레코드를 AES-128 ECB로 암호화
+	body := makeRecord(TagParaHeader, 0, makeParaHeader(0, 0))
+	body = append(body, make([]byte, aes.BlockSize-len(body)%aes.BlockSize)...)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encrypted := make([]byte, len(body))
+	for i := 0; i < len(body); i += aes.BlockSize {
+		block.Encrypt(encrypted[i:i+aes.BlockSize], body[i:i+aes.BlockSize])
+	}
+
+	stream := append(makeRecord(TagDistributeDocData, 0, docData), encrypted...)
+	decrypted, err := DecryptViewText(stream)
+	if err != nil {
+		t.Fatalf("DecryptViewText failed: %v", err)
+	}
+	if !bytes.Equal(decrypted, body) {
+		t.Errorf("decrypted body mismatch:\n got %x\nwant %x", decrypted, body)
+	}
+
+	if _, err := DecryptViewText(makeRecord(TagParaHeader, 0, make([]byte, 24))); err == nil {
+		t.Error("expected error for stream without DISTRIBUTE_DOC_DATA")
+	}
+}
+
+// makeSummaryInfo builds a HwpSummaryInformation property set stream.
+func makeSummaryInfo(props map[uint32][]byte) []byte {
+	ids := make([]uint32, 0, len(props))
+	for id := range props {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	// 속성 집합: 크기, 속성 수, (ID, 오프셋) 목록, 값
+	var values []byte
+	var entries []byte
+	base := 8 + 8*len(ids)
+	for _, id := range ids {
+		entries = binary.LittleEndian.AppendUint32(entries, id)
+		entries = binary.LittleEndian.AppendUint32(entries, uint32(base+len(values)))
+		values = append(values, props[id]...)
+	}
+	set := binary.LittleEndian.AppendUint32(nil, uint32(base+len(values)))
+	set = binary.LittleEndian.AppendUint32(set, uint32(len(ids)))
+	set = append(append(set, entries...), values...)
+
+	data := []byte{0xFE, 0xFF, 0, 0, 0, 0, 0, 0}
+	data = append(data, make([]byte, 16)...) // CLSID
+	data = binary.LittleEndian.AppendUint32(data, 1)
+	data = append(data, make([]byte, 16)...) // FMTID
+	data = binary.LittleEndian.AppendUint32(data, 48)
+	return append(data, set...)
+}
+
+// lpwstrProperty encodes a VT_LPWSTR property value.
+func lpwstrProperty(s string) []byte {
+	data := binary.LittleEndian.AppendUint32(nil, 0x1F)
+	data = binary.LittleEndian.AppendUint32(data, uint32(len([]rune(s))+1))
+	for _, r := range s + "\x00" {
+		data = binary.LittleEndian.AppendUint16(data, uint16(r))
+	}
+	return append(data, make([]byte, len(data)%4)...)
+}
+
+func TestParseSummaryInfo(t *testing.T) {
+	created := binary.LittleEndian.AppendUint32(nil, 0x40)
+	created = binary.LittleEndian.AppendUint64(created, 133129611500000000) // 2022-11-15T04:45:50Z
+	pages := binary.LittleEndian.AppendUint32(nil, 0x03)
+	pages = binary.LittleEndian.AppendUint32(pages, 3)
+
+	data := makeSummaryInfo(map[uint32][]byte{
+		PropTitle:       lpwstrProperty("채용 공고"),
+		PropAuthor:      lpwstrProperty("홍길동"),
+		PropLastSavedBy: lpwstrProperty("mcst"),
+		PropCreateTime:  created,
+		PropPageCount:   pages,
+	})
+
+	info, err := ParseSummaryInfo(data)
+	if err != nil {
+		t.Fatalf("ParseSummaryInfo failed: %v", err)
+	}
+	if info.Title != "채용 공고" || info.Author != "홍길동" || info.LastSavedBy != "mcst" {
+		t.Errorf("unexpected strings: %+v", info)
+	}
+	if got := info.Created.Format(time.RFC3339); got != "2022-11-15T04:45:50Z" {
+		t.Errorf("Created = %s", got)
+	}
+	if !info.Modified.IsZero() || info.PageCount != 3 {
+		t.Errorf("unexpected modified/page count: %+v", info)
+	}
+
+	if _, err := ParseSummaryInfo(make([]byte, 48)); err == nil {
+		t.Error("expected error for invalid byte order")
+	}
+}
+
 func TestCharShape_Attributes(t *testing.T) {
 	cs := &CharShape{
 		Attributes: 0x03, // Bold + Italic
@@ -273,4 +389,1323 @@ func TestCharShape_Attributes(t *testing.T) {
 	if fontSize != 10.0 {
 		t.Errorf("Expected font size 10.0pt, got %f", fontSize)
 	}
+
+	// 진하게(비트 1)만 설정
+	cs = &CharShape{Attributes: 0x02, ShadeColor: 0xFFFFFFFF}
+	if !cs.IsBold() || cs.IsItalic() {
+		t.Error("Expected bold only for attribute bit 1")
+	}
+	if cs.HasShade() {
+		t.Error("Expected no shade for 0xFFFFFFFF")
+	}
+
+	cs = &CharShape{Attributes: 1<<15 | 1<<2 | 1<<18, ShadeColor: 0x0000FFFF}
+	if !cs.IsSuperscript() || cs.IsSubscript() {
+		t.Error("Expected superscript only")
+	}
+	if !cs.IsUnderline() || !cs.IsStrikeout() {
+		t.Error("Expected underline and strikeout")
+	}
+	if !cs.HasShade() || cs.ShadeColorHex() != "#FFFF00" {
+		t.Errorf("Expected shade #FFFF00, got %q", cs.ShadeColorHex())
+	}
+}
+
+func TestSectionParser_CharShapeRuns(t *testing.T) {
+	// "가\x0b나다": 컨트롤 문자가 8 WCHAR를 차지하므로 "나"의 위치는 9
+	charShapes := make([]byte, 0, 24)
+	for _, ref := range [][2]uint32{{0, 1}, {9, 2}, {10, 1}} {
+		charShapes = binary.LittleEndian.AppendUint32(charShapes, ref[0])
+		charShapes = binary.LittleEndian.AppendUint32(charShapes, ref[1])
+	}
+
+	var data []byte
+	data = append(data, makeRecord(TagParaHeader, 0, makeParaHeader(0, 0))...)
+	data = append(data, makeRecord(TagParaText, 1, encodeParaText("가\x0b나다", CtrlEquation))...)
+	data = append(data, makeRecord(TagParaCharShape, 1, charShapes)...)
+
+	section, err := NewSectionParser(&DocInfo{}).Parse(data)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(section.Paragraphs) != 1 {
+		t.Fatalf("Expected 1 paragraph, got %d", len(section.Paragraphs))
+	}
+
+	runs := section.Paragraphs[0].TextRuns()
+	expected := []TextRun{
+		{Text: "가", CharShapeID: 1},
+		{Text: "나", CharShapeID: 2},
+		{Text: "다", CharShapeID: 1},
+	}
+	if len(runs) != len(expected) {
+		t.Fatalf("Expected %d runs, got %d: %+v", len(expected), len(runs), runs)
+	}
+	for i, want := range expected {
+		if runs[i] != want {
+			t.Errorf("run %d: expected %+v, got %+v", i, want, runs[i])
+		}
+	}
+}
+
+func TestSectionParser_Footnote(t *testing.T) {
+	var data []byte
+	data = append(data, makeRecord(TagParaHeader, 0, makeParaHeader(0, 0))...)
+	data = append(data, makeRecord(TagParaText, 1, encodeParaText("본문\x11 계속", CtrlFootnote))...)
+	data = append(data, makeRecord(TagCtrlHeader, 1, ctrlIDBytes(CtrlFootnote))...)
+	data = append(data, makeRecord(TagListHeader, 2, make([]byte, 8))...)
+	data = append(data, makeRecord(TagParaHeader, 2, makeParaHeader(0, 0))...)
+	data = append(data, makeRecord(TagParaText, 3, encodeParaText("각주 내용", ""))...)
+
+	section, err := NewSectionParser(&DocInfo{}).Parse(data)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(section.Paragraphs) != 1 {
+		t.Fatalf("Expected 1 paragraph, got %d", len(section.Paragraphs))
+	}
+
+	runs := section.Paragraphs[0].TextRuns()
+	if len(runs) != 3 || runs[1].Note == nil {
+		t.Fatalf("Expected note between two runs, got %+v", runs)
+	}
+	if runs[0].Text != "본문" || runs[2].Text != " 계속" {
+		t.Errorf("unexpected runs: %+v", runs)
+	}
+
+	p := &Parser{docInfo: &DocInfo{}}
+	doc := ir.NewDocument()
+	irRuns := p.convertRuns(doc, runs)
+	if len(irRuns) != 3 || irRuns[1].Style.FootnoteRef != "1" {
+		t.Fatalf("Expected footnote ref run, got %+v", irRuns)
+	}
+	if len(doc.Footnotes) != 1 || doc.Footnotes[0].Text() != "각주 내용" {
+		t.Errorf("unexpected footnotes: %+v", doc.Footnotes)
+	}
+}
+
+func TestSectionParser_HeaderFooter(t *testing.T) {
+	headAttr := append(ctrlIDBytes(CtrlHeader), 0x01, 0, 0, 0) // 짝수 쪽
+
+	var data []byte
+	data = append(data, makeRecord(TagParaHeader, 0, makeParaHeader(0, 0))...)
+	data = append(data, makeRecord(TagParaText, 1, encodeParaText("\x10본문", CtrlHeader))...)
+	data = append(data, makeRecord(TagCtrlHeader, 1, headAttr)...)
+	data = append(data, makeRecord(TagListHeader, 2, make([]byte, 8))...)
+	data = append(data, makeRecord(TagParaHeader, 2, makeParaHeader(0, 0))...)
+	data = append(data, makeRecord(TagParaText, 3, encodeParaText("머리말", ""))...)
+
+	section, err := NewSectionParser(&DocInfo{}).Parse(data)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(section.Blocks) != 1 || section.Blocks[0].Paragraph.Text != "본문" {
+		t.Fatalf("Expected only the body paragraph, got %+v", section.Blocks)
+	}
+	if len(section.HeaderFooters) != 1 {
+		t.Fatalf("Expected 1 header, got %d", len(section.HeaderFooters))
+	}
+
+	hf := section.HeaderFooters[0]
+	if hf.Kind != KindHeader || hf.Apply != ApplyEvenPages {
+		t.Errorf("unexpected header: %+v", hf)
+	}
+
+	p := &Parser{docInfo: &DocInfo{}}
+	doc := ir.NewDocument()
+	p.convertSectionToIR(doc, section)
+	p.convertSectionToIR(doc, section)
+	if len(doc.Headers) != 1 || doc.Headers[0].Scope != ir.PageScopeEven || doc.Headers[0].Text() != "머리말" {
+		t.Errorf("unexpected document headers: %+v", doc.Headers)
+	}
+}
+
+// makeEqEdit builds EQEDIT record data holding an equation script.
+func makeEqEdit(script string) []byte {
+	data := make([]byte, 4) // 속성
+	data = binary.LittleEndian.AppendUint16(data, uint16(len([]rune(script))))
+	for _, r := range script {
+		data = binary.LittleEndian.AppendUint16(data, uint16(r))
+	}
+	return data
+}
+
+func TestSectionParser_Equation(t *testing.T) {
+	var data []byte
+	// 인라인 수식
+	data = append(data, makeRecord(TagParaHeader, 0, makeParaHeader(0, 0))...)
+	data = append(data, makeRecord(TagParaText, 1, encodeParaText("넓이는 \x0b 이다", CtrlEquation))...)
+	data = append(data, makeRecord(TagCtrlHeader, 1, ctrlIDBytes(CtrlEquation))...)
+	data = append(data, makeRecord(TagEqEdit, 2, makeEqEdit("pi r^2"))...)
+	// 수식만 있는 문단
+	data = append(data, makeRecord(TagParaHeader, 0, makeParaHeader(0, 0))...)
+	data = append(data, makeRecord(TagParaText, 1, encodeParaText("\x0b", CtrlEquation))...)
+	data = append(data, makeRecord(TagCtrlHeader, 1, ctrlIDBytes(CtrlEquation))...)
+	data = append(data, makeRecord(TagEqEdit, 2, makeEqEdit("{a} over {b}"))...)
+
+	section, err := NewSectionParser(&DocInfo{}).Parse(data)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(section.Paragraphs) != 2 {
+		t.Fatalf("Expected 2 paragraphs, got %d", len(section.Paragraphs))
+	}
+	if eqs := section.Paragraphs[0].Equations; len(eqs) != 1 || eqs[0].Script != "pi r^2" || eqs[0].Offset != 4 {
+		t.Fatalf("unexpected equations: %+v", eqs)
+	}
+
+	p := &Parser{docInfo: &DocInfo{}}
+	doc := ir.NewDocument()
+	p.convertSectionToIR(doc, section)
+
+	if len(doc.Content) != 2 {
+		t.Fatalf("Expected 2 blocks, got %d", len(doc.Content))
+	}
+	if got := doc.Content[0].Paragraph.Text; got != "넓이는 $\\pi r^{2}$ 이다" {
+		t.Errorf("inline equation text = %q", got)
+	}
+	if block := doc.Content[1]; block.Type != ir.BlockTypeMath || block.Math.TeX != "\\frac{a}{b}" {
+		t.Errorf("Expected display math block, got %+v", block)
+	}
+}
+
+// makeFieldCtrlHeader builds CTRL_HEADER data for a field start control.
+func makeFieldCtrlHeader(ctrlID, command string) []byte {
+	data := append(ctrlIDBytes(ctrlID), make([]byte, 5)...) // 속성 + 기타 속성
+	data = binary.LittleEndian.AppendUint16(data, uint16(len([]rune(command))))
+	for _, r := range command {
+		data = binary.LittleEndian.AppendUint16(data, uint16(r))
+	}
+	return binary.LittleEndian.AppendUint32(data, 1)
+}
+
+func TestSectionParser_Hyperlink(t *testing.T) {
+	var data []byte
+	data = append(data, makeRecord(TagParaHeader, 0, makeParaHeader(0, 0))...)
+	data = append(data, makeRecord(TagParaText, 1, encodeParaText("홈페이지(\x03www.example.com\x04) 참고", CtrlFieldHyperlink))...)
+	data = append(data, makeRecord(TagCtrlHeader, 1, makeFieldCtrlHeader(CtrlFieldHyperlink, `www.example.com;1;0;0;`))...)
+
+	section, err := NewSectionParser(&DocInfo{}).Parse(data)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	para := section.Paragraphs[0]
+	if len(para.Fields) != 1 {
+		t.Fatalf("Expected 1 field, got %d", len(para.Fields))
+	}
+	if f := para.Fields[0]; f.Start != 5 || f.End != 20 {
+		t.Errorf("unexpected field range: %d-%d", f.Start, f.End)
+	}
+
+	runs := para.TextRuns()
+	if len(runs) != 3 || runs[1].Text != "www.example.com" || runs[1].Link != "http://www.example.com" {
+		t.Fatalf("unexpected runs: %+v", runs)
+	}
+}
+
+func TestSectionParser_MemoAndRevision(t *testing.T) {
+	var data []byte
+	data = append(data, makeRecord(TagParaHeader, 0, makeParaHeader(0, 0))...)
+	data = append(data, makeRecord(TagParaText, 1, encodeParaText("조문 \x03개정안\x04 및 \x03삭제할 문구\x04 끝", CtrlFieldMemo))...)
+	data = append(data, makeRecord(TagCtrlHeader, 1, makeFieldCtrlHeader(CtrlFieldMemo, "Author:wstring:3:검토자 CreateDateTime:wstring:10:2024-05-01 "))...)
+	data = append(data, makeRecord(TagCtrlHeader, 1, makeFieldCtrlHeader(CtrlFieldRevDelete, ""))...)
+
+	// 구역 끝의 메모 내용
+	data = append(data, makeRecord(TagMemoList, 0, binary.LittleEndian.AppendUint32(nil, 1))...)
+	data = append(data, makeRecord(TagListHeader, 1, make([]byte, 8))...)
+	data = append(data, makeRecord(TagParaHeader, 1, makeParaHeader(0, 0))...)
+	data = append(data, makeRecord(TagParaText, 2, encodeParaText("수정 필요", ""))...)
+
+	section, err := NewSectionParser(&DocInfo{}).Parse(data)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if len(section.Memos) != 1 || joinParagraphText(section.Memos[0].Paragraphs) != "수정 필요" {
+		t.Fatalf("unexpected memos: %+v", section.Memos)
+	}
+
+	para := section.Paragraphs[0]
+	if len(para.Fields) != 2 || para.Fields[0].Parameter("Author") != "검토자" || para.Fields[1].Change() != "delete" {
+		t.Fatalf("unexpected fields: %+v", para.Fields)
+	}
+
+	// 교정 부호 필드의 ID(1)가 가리키는 변경 내역의 작성자
+	var info []byte
+	info = append(info, makeRecord(TagTrackChange2, 0, []byte{2, 0, 0, 0, 1, 0})...)
+	info = append(info, makeRecord(TagTrackChangeAuthor, 0, append([]byte{3, 0}, encodeParaText("편집자", "")[:6]...))...)
+	docInfo, err := ParseDocInfo(info)
+	if err != nil {
+		t.Fatalf("ParseDocInfo failed: %v", err)
+	}
+	if author := docInfo.ChangeAuthor(1); author != "편집자" {
+		t.Fatalf("Expected change author 편집자, got %q", author)
+	}
+
+	p := &Parser{docInfo: docInfo}
+	doc := ir.NewDocument()
+	p.convertSectionToIR(doc, section)
+
+	if len(doc.Content) != 1 {
+		t.Fatalf("Expected 1 block, got %d", len(doc.Content))
+	}
+	var deleted *ir.Run
+	for i, run := range doc.Content[0].Paragraph.Runs {
+		if run.Style.Change == ir.ChangeDelete {
+			deleted = &doc.Content[0].Paragraph.Runs[i]
+		}
+	}
+	if deleted == nil || deleted.Style.ChangeAuthor != "편집자" {
+		t.Errorf("Expected deleted run by 편집자, got %+v", deleted)
+	}
+	expected := "조문 {==개정안==}{>>검토자: 수정 필요<<} 및 {--삭제할 문구--} 끝"
+	if got := doc.Content[0].Paragraph.Text; got != expected {
+		t.Errorf("paragraph text = %q, want %q", got, expected)
+	}
+	if len(doc.Comments) != 1 || doc.Comments[0].Author != "검토자" || doc.Comments[0].Date != "2024-05-01" {
+		t.Errorf("unexpected comments: %+v", doc.Comments)
+	}
+}
+
+// makeBookmarkData builds CTRL_DATA holding a parameter set with the bookmark name.
+func makeBookmarkData(name string) []byte {
+	data := []byte{0x1B, 0x02, 0x01, 0x00, 0x00, 0x00} // 셋 ID, 아이템 수 1, 예약
+	data = append(data, 0x00, 0x40, byte(paramTypeString), 0x00)
+	data = binary.LittleEndian.AppendUint16(data, uint16(len([]rune(name))))
+	for _, r := range name {
+		data = binary.LittleEndian.AppendUint16(data, uint16(r))
+	}
+	return data
+}
+
+func TestSectionParser_BookmarkAndCrossRef(t *testing.T) {
+	var data []byte
+	data = append(data, makeRecord(TagParaHeader, 0, makeParaHeader(0, 0))...)
+	data = append(data, makeRecord(TagParaText, 1, encodeParaText("\x16[별표 3] 서식", CtrlBookmark))...)
+	data = append(data, makeRecord(TagCtrlHeader, 1, ctrlIDBytes(CtrlBookmark))...)
+	data = append(data, makeRecord(TagCtrlData, 2, makeBookmarkData("별표 3"))...)
+	data = append(data, makeRecord(TagParaHeader, 0, makeParaHeader(0, 0))...)
+	data = append(data, makeRecord(TagParaText, 1, encodeParaText("\x03별표 3\x04 참조", CtrlFieldCrossRef))...)
+	data = append(data, makeRecord(TagCtrlHeader, 1, makeFieldCtrlHeader(CtrlFieldCrossRef, `?#별표 3;0;0;0`))...)
+
+	section, err := NewSectionParser(&DocInfo{}).Parse(data)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if bms := section.Paragraphs[0].Bookmarks; len(bms) != 1 || bms[0].Name != "별표 3" || bms[0].Offset != 0 {
+		t.Fatalf("unexpected bookmarks: %+v", bms)
+	}
+
+	p := &Parser{docInfo: &DocInfo{}}
+	doc := ir.NewDocument()
+	p.convertSectionToIR(doc, section)
+
+	if len(doc.Content) != 2 {
+		t.Fatalf("Expected 2 blocks, got %d", len(doc.Content))
+	}
+	if anchors := doc.Content[0].Anchors; len(anchors) != 1 || anchors[0] != "별표-3" {
+		t.Errorf("unexpected anchors: %v", anchors)
+	}
+	runs := doc.Content[1].Paragraph.Runs
+	if len(runs) == 0 || runs[0].Text != "별표 3" || runs[0].Style.Link != "#별표-3" {
+		t.Errorf("expected cross-reference link, got %+v", runs)
+	}
+}
+
+func TestParser_ConvertRuns(t *testing.T) {
+	p := &Parser{docInfo: &DocInfo{
+		CharShapes: []*CharShape{
+			{ShadeColor: 0xFFFFFFFF},
+			{Attributes: 0x02, ShadeColor: 0xFFFFFFFF},
+			{Attributes: 0x02, ShadeColor: 0xFFFFFFFF, Height: 1200},
+		},
+	}}
+
+	runs := p.convertRuns(ir.NewDocument(), []TextRun{
+		{Text: " 일반 ", CharShapeID: 0},
+		{Text: "굵게", CharShapeID: 1},
+		{Text: "도 굵게 ", CharShapeID: 2},
+	})
+
+	if len(runs) != 2 {
+		t.Fatalf("Expected 2 runs, got %d: %+v", len(runs), runs)
+	}
+	if runs[0].Text != "일반 " || runs[0].Style.Bold {
+		t.Errorf("unexpected first run: %+v", runs[0])
+	}
+	if runs[1].Text != "굵게도 굵게" || !runs[1].Style.Bold {
+		t.Errorf("unexpected second run: %+v", runs[1])
+	}
+}
+
+func TestStyle_HeadingLevel(t *testing.T) {
+	tests := []struct {
+		name     string
+		engName  string
+		expected int
+	}{
+		{"바탕글", "Normal", 0},
+		{"개요 1", "Outline 1", 1},
+		{"개요 3", "", 3},
+		{"제목", "", 1},
+		{"부제목", "", 2},
+		{"Heading 2", "heading 2", 2},
+		{"사용자 스타일", "heading 4", 4},
+		{"양식제목", "", 0},
+	}
+
+	for _, tc := range tests {
+		style := &Style{Name: tc.name, EngName: tc.engName}
+		if got := style.HeadingLevel(); got != tc.expected {
+			t.Errorf("HeadingLevel(%q, %q) = %d, want %d", tc.name, tc.engName, got, tc.expected)
+		}
+	}
+}
+
+func TestSectionParser_ParaHeader(t *testing.T) {
+	para := NewSectionParser(&DocInfo{}).parseParaHeader(makeParaHeader(7, 3))
+	if para.ParaShapeID != 7 {
+		t.Errorf("Expected ParaShapeID 7, got %d", para.ParaShapeID)
+	}
+	if para.StyleID != 3 {
+		t.Errorf("Expected StyleID 3, got %d", para.StyleID)
+	}
+}
+
+func TestParser_HeadingLevel(t *testing.T) {
+	p := &Parser{docInfo: &DocInfo{
+		ParaShapes: []*ParaShape{
+			{},
+			{Attributes1: ParaHeadOutline<<23 | 2<<25}, // 개요 3수준
+			{Attributes1: ParaHeadBullet<<23 | 1<<25},  // 글머리표
+		},
+		Styles: []*Style{
+			{Name: "바탕글"},
+			{Name: "개요 2"},
+		},
+	}}
+
+	tests := []struct {
+		para     *Paragraph
+		expected int
+	}{
+		{&Paragraph{ParaShapeID: 0, StyleID: 0}, 0},
+		{&Paragraph{ParaShapeID: 1, StyleID: 0}, 3},
+		{&Paragraph{ParaShapeID: 2, StyleID: 0}, 0},
+		{&Paragraph{ParaShapeID: 0, StyleID: 1}, 2},
+		{&Paragraph{ParaShapeID: 1, StyleID: 1}, 3},
+		{&Paragraph{ParaShapeID: 9, StyleID: 9}, 0},
+	}
+
+	for i, tc := range tests {
+		if got := p.headingLevel(tc.para); got != tc.expected {
+			t.Errorf("case %d: headingLevel() = %d, want %d", i, got, tc.expected)
+		}
+	}
+}
+
+// makeRecord builds a raw record (header + data) for section stream tests.
+func makeRecord(tagID, level uint16, data []byte) []byte {
+	header := uint32(len(data))<<20 | uint32(level)<<10 | uint32(tagID)
+	buf := make([]byte, 4, 4+len(data))
+	binary.LittleEndian.PutUint32(buf, header)
+	return append(buf, data...)
+}
+
+// encodeParaText encodes text as UTF-16LE PARA_TEXT data.
+// Extended control chars in text (e.g. '\x0b') are expanded to controls carrying ctrlID.
+func encodeParaText(text string, ctrlID string) []byte {
+	var buf []byte
+	for _, r := range text {
+		if r < 0x20 && (isExtendedControl(uint16(r)) || r == CharFieldEnd) {
+			buf = binary.LittleEndian.AppendUint16(buf, uint16(r))
+			buf = append(buf, ctrlIDBytes(ctrlID)...)
+			buf = append(buf, make([]byte, 8)...)
+			buf = binary.LittleEndian.AppendUint16(buf, uint16(r))
+			continue
+		}
+		buf = binary.LittleEndian.AppendUint16(buf, uint16(r))
+	}
+	return binary.LittleEndian.AppendUint16(buf, CharPara)
+}
+
+// ctrlIDBytes returns the on-disk (little-endian) form of a control ID.
+func ctrlIDBytes(id string) []byte {
+	return []byte{id[3], id[2], id[1], id[0]}
+}
+
+// makeParaHeader builds PARA_HEADER data with the given style and para shape IDs.
+func makeParaHeader(paraShapeID uint16, styleID uint8) []byte {
+	data := make([]byte, 24)
+	binary.LittleEndian.PutUint16(data[8:10], paraShapeID)
+	data[10] = styleID
+	return data
+}
+
+// makeTableRecords builds a 1x1 table control at the given level.
+func makeTableRecords(level uint16, cellText string) []byte {
+	var buf []byte
+	buf = append(buf, makeRecord(TagCtrlHeader, level, append(ctrlIDBytes(CtrlTable), make([]byte, 40)...))...)
+
+	tableData := make([]byte, 18)
+	binary.LittleEndian.PutUint16(tableData[4:6], 1)
+	binary.LittleEndian.PutUint16(tableData[6:8], 1)
+	buf = append(buf, makeRecord(TagTable, level+1, tableData)...)
+
+	buf = append(buf, makeRecord(TagListHeader, level+1, make([]byte, 34))...)
+	buf = append(buf, makeRecord(TagParaHeader, level+1, makeParaHeader(0, 0))...)
+	buf = append(buf, makeRecord(TagParaText, level+2, encodeParaText(cellText, ""))...)
+	return buf
+}
+
+func TestSectionParser_ReadingOrder(t *testing.T) {
+	var data []byte
+	data = append(data, makeRecord(TagParaHeader, 0, makeParaHeader(0, 0))...)
+	data = append(data, makeRecord(TagParaText, 1, encodeParaText("첫 문단", ""))...)
+
+	// 표 앞뒤에 텍스트가 있는 문단
+	data = append(data, makeRecord(TagParaHeader, 0, makeParaHeader(0, 0))...)
+	data = append(data, makeRecord(TagParaText, 1, encodeParaText("앞\x0b뒤", CtrlTable))...)
+	data = append(data, makeTableRecords(1, "셀")...)
+
+	data = append(data, makeRecord(TagParaHeader, 0, makeParaHeader(0, 0))...)
+	data = append(data, makeRecord(TagParaText, 1, encodeParaText("마지막 문단", ""))...)
+
+	section, err := NewSectionParser(&DocInfo{}).Parse(data)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	expected := []struct {
+		blockType BlockType
+		text      string
+	}{
+		{BlockParagraph, "첫 문단"},
+		{BlockParagraph, "앞"},
+		{BlockTable, "셀"},
+		{BlockParagraph, "뒤"},
+		{BlockParagraph, "마지막 문단"},
+	}
+
+	if len(section.Blocks) != len(expected) {
+		t.Fatalf("Expected %d blocks, got %d", len(expected), len(section.Blocks))
+	}
+
+	for i, want := range expected {
+		block := section.Blocks[i]
+		if block.Type != want.blockType {
+			t.Errorf("block %d: expected type %d, got %d", i, want.blockType, block.Type)
+			continue
+		}
+		var got string
+		switch block.Type {
+		case BlockParagraph:
+			got = block.Paragraph.Text
+		case BlockTable:
+			got = block.Table.Cells[0][0].GetCellText()
+		}
+		if got != want.text {
+			t.Errorf("block %d: expected %q, got %q", i, want.text, got)
+		}
+	}
+
+	if len(section.Tables) != 1 {
+		t.Errorf("Expected 1 table, got %d", len(section.Tables))
+	}
+}
+
+func TestSectionParser_NestedTable(t *testing.T) {
+	var data []byte
+	data = append(data, makeRecord(TagParaHeader, 0, makeParaHeader(0, 0))...)
+	data = append(data, makeRecord(TagParaText, 1, encodeParaText("\x0b", CtrlTable))...)
+
+	// 바깥 표 셀: 중첩 표를 담은 문단 뒤에 문단 하나가 더 있다
+	data = append(data, makeRecord(TagCtrlHeader, 1, append(ctrlIDBytes(CtrlTable), make([]byte, 40)...))...)
+	tableData := make([]byte, 18)
+	binary.LittleEndian.PutUint16(tableData[4:6], 1)
+	binary.LittleEndian.PutUint16(tableData[6:8], 1)
+	data = append(data, makeRecord(TagTable, 2, tableData)...)
+	data = append(data, makeRecord(TagListHeader, 2, make([]byte, 34))...)
+	data = append(data, makeRecord(TagParaHeader, 2, makeParaHeader(0, 0))...)
+	data = append(data, makeRecord(TagParaText, 3, encodeParaText("바깥\x0b", CtrlTable))...)
+	data = append(data, makeTableRecords(3, "안쪽")...)
+	data = append(data, makeRecord(TagParaHeader, 2, makeParaHeader(0, 0))...)
+	data = append(data, makeRecord(TagParaText, 3, encodeParaText("끝", ""))...)
+
+	section, err := NewSectionParser(&DocInfo{}).Parse(data)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(section.Tables) != 1 {
+		t.Fatalf("Expected 1 top-level table, got %d", len(section.Tables))
+	}
+	cell := section.Tables[0].Cells[0][0]
+	if cell.GetCellText() != "바깥\n끝" {
+		t.Errorf("outer cell text = %q", cell.GetCellText())
+	}
+	if len(cell.Tables) != 1 || cell.Tables[0].Cells[0][0].GetCellText() != "안쪽" {
+		t.Fatalf("Expected nested table in cell, got %+v", cell.Tables)
+	}
+
+	p := &Parser{docInfo: &DocInfo{}}
+	doc := ir.NewDocument()
+	p.convertSectionToIR(doc, section)
+
+	irCell := doc.Content[0].Table.Cells[0][0]
+	if irCell.Text != "바깥\n안쪽\n끝" {
+		t.Errorf("IR cell text = %q", irCell.Text)
+	}
+	if len(irCell.Tables) != 1 || irCell.Tables[0].Cells[0][0].Text != "안쪽" {
+		t.Errorf("Expected nested IR table, got %+v", irCell.Tables)
+	}
+}
+
+func TestSectionParser_TextBox(t *testing.T) {
+	var data []byte
+	data = append(data, makeRecord(TagParaHeader, 0, makeParaHeader(0, 0))...)
+	data = append(data, makeRecord(TagParaText, 1, encodeParaText("앞\x0b뒤", CtrlGSO))...)
+
+	// 사각형 글상자: SHAPE_COMPONENT 아래 LIST_HEADER와 문단
+	data = append(data, makeRecord(TagCtrlHeader, 1, append(ctrlIDBytes(CtrlGSO), make([]byte, 42)...))...)
+	data = append(data, makeRecord(TagShapeComponent, 2, ctrlIDBytes(ShapeRectangle))...)
+	data = append(data, makeRecord(TagListHeader, 3, make([]byte, 8))...)
+	data = append(data, makeRecord(TagParaHeader, 3, makeParaHeader(0, 0))...)
+	data = append(data, makeRecord(TagParaText, 4, encodeParaText("핵심 요약", ""))...)
+	data = append(data, makeRecord(TagShapeRectangle, 3, make([]byte, 33))...)
+
+	section, err := NewSectionParser(&DocInfo{}).Parse(data)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(section.Blocks) != 3 {
+		t.Fatalf("Expected 3 blocks, got %d", len(section.Blocks))
+	}
+	block := section.Blocks[1]
+	if block.Type != BlockTextBox || joinParagraphText(block.TextBox.Paragraphs) != "핵심 요약" {
+		t.Fatalf("Expected text box between paragraphs, got %+v", block)
+	}
+	if section.Blocks[0].Paragraph.Text != "앞" || section.Blocks[2].Paragraph.Text != "뒤" {
+		t.Errorf("unexpected surrounding paragraphs: %+v", section.Blocks)
+	}
+
+	p := &Parser{docInfo: &DocInfo{}}
+	doc := ir.NewDocument()
+	p.convertSectionToIR(doc, section)
+	if len(doc.Content) != 3 || doc.Content[1].Type != ir.BlockTypeTextBox {
+		t.Errorf("Expected IR text box block, got %+v", doc.Content)
+	}
+}
+
+func TestTextExtractor_TabControl(t *testing.T) {
+	te := NewTextExtractor()
+
+	// 탭은 16바이트 인라인 컨트롤: 추가 14바이트가 텍스트로 새어 나오면 안 된다
+	data := []byte{0x41, 0x00, 0x09, 0x00}
+	data = append(data, 0x8c, 0x05, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x09, 0x00)
+	data = append(data, 0x42, 0x00)
+
+	text, _ := te.ExtractTextWithControls(data)
+	if text != "A\tB" {
+		t.Errorf("Expected 'A\\tB', got %q", text)
+	}
+}
+
+// makePictureRecords builds a GSO picture control at the given level.
+func makePictureRecords(level uint16, binDataID uint16, width, height int32) []byte {
+	var buf []byte
+
+	ctrlData := make([]byte, 46)
+	copy(ctrlData[0:4], ctrlIDBytes(CtrlGSO))
+	binary.LittleEndian.PutUint32(ctrlData[16:20], uint32(width))
+	binary.LittleEndian.PutUint32(ctrlData[20:24], uint32(height))
+	buf = append(buf, makeRecord(TagCtrlHeader, level, ctrlData)...)
+
+	compData := make([]byte, 8)
+	copy(compData[0:4], ctrlIDBytes(ShapePicture))
+	copy(compData[4:8], ctrlIDBytes(ShapePicture))
+	buf = append(buf, makeRecord(TagShapeComponent, level+1, compData)...)
+
+	picData := make([]byte, 90)
+	binary.LittleEndian.PutUint32(picData[52:56], 1000) // crop right
+	binary.LittleEndian.PutUint32(picData[56:60], 500)  // crop bottom
+	binary.LittleEndian.PutUint16(picData[71:73], binDataID)
+	buf = append(buf, makeRecord(TagShapePicture, level+2, picData)...)
+	return buf
+}
+
+func TestSectionParser_Picture(t *testing.T) {
+	var data []byte
+	data = append(data, makeRecord(TagParaHeader, 0, makeParaHeader(0, 0))...)
+	data = append(data, makeRecord(TagParaText, 1, encodeParaText("\x0b", CtrlGSO))...)
+	data = append(data, makePictureRecords(1, 3, 7200, 3600)...)
+
+	section, err := NewSectionParser(&DocInfo{}).Parse(data)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(section.Images) != 1 {
+		t.Fatalf("Expected 1 image, got %d", len(section.Images))
+	}
+	if len(section.Blocks) != 1 || section.Blocks[0].Type != BlockImage {
+		t.Fatalf("Expected a single image block, got %d blocks", len(section.Blocks))
+	}
+
+	img := section.Images[0]
+	if img.BinDataID != 3 {
+		t.Errorf("Expected BinDataID 3, got %d", img.BinDataID)
+	}
+	if img.Width != 7200 || img.Height != 3600 {
+		t.Errorf("Expected size 7200x3600, got %dx%d", img.Width, img.Height)
+	}
+	if img.Crop.Right != 1000 || img.Crop.Bottom != 500 {
+		t.Errorf("Unexpected crop: %+v", img.Crop)
+	}
+	if hwpUnitToPixels(img.Width) != 96 {
+		t.Errorf("Expected 96px width, got %d", hwpUnitToPixels(img.Width))
+	}
+}
+
+func TestParser_CellImages(t *testing.T) {
+	var data []byte
+	data = append(data, makeRecord(TagParaHeader, 0, makeParaHeader(0, 0))...)
+	data = append(data, makeRecord(TagParaText, 1, encodeParaText("\x0b", CtrlTable))...)
+	data = append(data, makeRecord(TagCtrlHeader, 1, append(ctrlIDBytes(CtrlTable), make([]byte, 40)...))...)
+	tableData := make([]byte, 18)
+	binary.LittleEndian.PutUint16(tableData[4:6], 1)
+	binary.LittleEndian.PutUint16(tableData[6:8], 2)
+	data = append(data, makeRecord(TagTable, 2, tableData)...)
+
+	data = append(data, makeRecord(TagListHeader, 2, makeCellListHeader(0, 0, 1, 1, 0))...)
+	data = append(data, makeRecord(TagParaHeader, 2, makeParaHeader(0, 0))...)
+	data = append(data, makeRecord(TagParaText, 3, encodeParaText("서명", ""))...)
+
+	// 둘째 셀: 글자 뒤에 그림이 놓인 문단, 그 뒤의 문단
+	data = append(data, makeRecord(TagListHeader, 2, makeCellListHeader(1, 0, 1, 1, 0))...)
+	data = append(data, makeRecord(TagParaHeader, 2, makeParaHeader(0, 0))...)
+	data = append(data, makeRecord(TagParaText, 3, encodeParaText("(인)\x0b", CtrlGSO))...)
+	data = append(data, makePictureRecords(3, 3, 7200, 3600)...)
+	data = append(data, makeRecord(TagParaHeader, 2, makeParaHeader(0, 0))...)
+	data = append(data, makeRecord(TagParaText, 3, encodeParaText("날짜", ""))...)
+
+	docInfo := &DocInfo{BinDataList: []*BinDataInfo{{Type: 1, BinDataID: 3, Extension: "png"}}}
+	section, err := NewSectionParser(docInfo).Parse(data)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	p := &Parser{docInfo: docInfo, options: parser.Options{ExtractImages: true}}
+	doc := ir.NewDocument()
+	p.convertSectionToIR(doc, section)
+
+	// 그림은 표 뒤가 아니라 셀 안에 남는다
+	if len(doc.Content) != 1 || doc.Content[0].Type != ir.BlockTypeTable {
+		t.Fatalf("Expected only the table block, got %+v", doc.Content)
+	}
+	cell := doc.Content[0].Table.Cells[0][1]
+	if len(cell.Images) != 1 || cell.Images[0].ID != "BIN0003" {
+		t.Fatalf("Expected the picture in the cell, got %+v", cell.Images)
+	}
+	expected := "(인)\n" + cell.Images[0].Markdown() + "\n날짜"
+	if cell.Text != expected {
+		t.Errorf("cell text = %q, want %q", cell.Text, expected)
+	}
+}
+
+// makeAutoNumber builds CTRL_HEADER data of an automatic number (atno) or new number (nwno) control.
+func makeAutoNumber(ctrlID string, kind parser.NumberKind, number uint16) []byte {
+	data := append(ctrlIDBytes(ctrlID), make([]byte, 12)...)
+	binary.LittleEndian.PutUint32(data[4:8], uint32(kind))
+	binary.LittleEndian.PutUint16(data[8:10], number)
+	return data
+}
+
+// makeCaptionRecords builds a caption list of one paragraph at the given level.
+// '\x12' in text becomes an automatic number of the given kind.
+func makeCaptionRecords(level uint16, text string, kind parser.NumberKind) []byte {
+	var buf []byte
+	buf = append(buf, makeRecord(TagListHeader, level, make([]byte, 34))...)
+	buf = append(buf, makeRecord(TagParaHeader, level, makeParaHeader(0, 0))...)
+	buf = append(buf, makeRecord(TagParaText, level+1, encodeParaText(text, CtrlAutoNumber))...)
+	buf = append(buf, makeRecord(TagCtrlHeader, level+1, makeAutoNumber(CtrlAutoNumber, kind, 0))...)
+	return buf
+}
+
+func TestSectionParser_Captions(t *testing.T) {
+	var data []byte
+
+	// 표 캡션은 TABLE 레코드 앞의 LIST_HEADER
+	table := makeTableRecords(1, "셀")
+	ctrlHeader := table[:4+44]
+	data = append(data, makeRecord(TagParaHeader, 0, makeParaHeader(0, 0))...)
+	data = append(data, makeRecord(TagParaText, 1, encodeParaText("\x0b", CtrlTable))...)
+	data = append(data, ctrlHeader...)
+	data = append(data, makeCaptionRecords(2, "표 \x12 인구 현황", parser.NumberTable)...)
+	data = append(data, table[len(ctrlHeader):]...)
+
+	// 그림 캡션은 개체 뒤의 LIST_HEADER
+	data = append(data, makeRecord(TagParaHeader, 0, makeParaHeader(0, 0))...)
+	data = append(data, makeRecord(TagParaText, 1, encodeParaText("\x0b", CtrlGSO))...)
+	data = append(data, makePictureRecords(1, 1, 7200, 3600)...)
+	data = append(data, makeCaptionRecords(2, "그림 \x12 지도", parser.NumberPicture)...)
+
+	// 새 번호 지정 뒤의 표
+	data = append(data, makeRecord(TagParaHeader, 0, makeParaHeader(0, 0))...)
+	data = append(data, makeRecord(TagParaText, 1, encodeParaText("\x15\x0b", CtrlNewNumber))...)
+	data = append(data, makeRecord(TagCtrlHeader, 1, makeAutoNumber(CtrlNewNumber, parser.NumberTable, 10))...)
+	data = append(data, ctrlHeader...)
+	data = append(data, makeCaptionRecords(2, "<표 \x12>", parser.NumberTable)...)
+	data = append(data, table[len(ctrlHeader):]...)
+
+	docInfo := &DocInfo{Properties: &DocumentProperties{TableStart: 3}}
+	section, err := NewSectionParser(docInfo).Parse(data)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if len(section.Tables) != 2 || len(section.Images) != 1 {
+		t.Fatalf("Expected 2 tables and 1 image, got %d and %d", len(section.Tables), len(section.Images))
+	}
+
+	// 캡션 LIST_HEADER는 셀이 되지 않는다
+	if got := section.Tables[0].Cells[0][0].GetCellText(); got != "셀" {
+		t.Errorf("Expected cell text %q, got %q", "셀", got)
+	}
+	if got := section.Tables[0].Caption; got != "표 3 인구 현황" {
+		t.Errorf("Expected first table caption %q, got %q", "표 3 인구 현황", got)
+	}
+	if got := section.Images[0].Caption; got != "그림 1 지도" {
+		t.Errorf("Expected picture caption %q, got %q", "그림 1 지도", got)
+	}
+	if got := section.Tables[1].Caption; got != "<표 10>" {
+		t.Errorf("Expected renumbered table caption %q, got %q", "<표 10>", got)
+	}
+
+	p := &Parser{docInfo: docInfo}
+	doc := ir.NewDocument()
+	p.convertSectionToIR(doc, section)
+	if len(doc.Content) == 0 || doc.Content[0].Table == nil || doc.Content[0].Table.Caption != "표 3 인구 현황" {
+		t.Errorf("Expected IR table with caption, got %+v", doc.Content)
+	}
+}
+
+func TestParagraph_InsertControlText(t *testing.T) {
+	para := &Paragraph{
+		Text:          "표  제목",
+		Controls:      []ControlInfo{{Type: 0x12, Offset: 2}, {Type: 0x0b, Offset: 2}, {Type: 0x0b, Offset: 4}},
+		charPositions: []int{0, 1, 10, 11, 12},
+	}
+	para.insertControlText(0, "12")
+
+	if para.Text != "표 12 제목" {
+		t.Errorf("Expected %q, got %q", "표 12 제목", para.Text)
+	}
+	if got := []int{para.Controls[0].Offset, para.Controls[1].Offset, para.Controls[2].Offset}; got[0] != 2 || got[1] != 4 || got[2] != 6 {
+		t.Errorf("Unexpected control offsets: %v", got)
+	}
+	if want := []int{0, 1, 2, 2, 10, 11, 12}; fmt.Sprint(para.charPositions) != fmt.Sprint(want) {
+		t.Errorf("Expected positions %v, got %v", want, para.charPositions)
+	}
+}
+
+// makeOLERecords builds a GSO OLE object control at the given level.
+func makeOLERecords(level uint16, binDataID uint16) []byte {
+	var buf []byte
+
+	ctrlData := make([]byte, 46)
+	copy(ctrlData[0:4], ctrlIDBytes(CtrlGSO))
+	binary.LittleEndian.PutUint32(ctrlData[16:20], 14400)
+	binary.LittleEndian.PutUint32(ctrlData[20:24], 7200)
+	buf = append(buf, makeRecord(TagCtrlHeader, level, ctrlData)...)
+
+	compData := make([]byte, 8)
+	copy(compData[0:4], ctrlIDBytes(ShapeOLE))
+	copy(compData[4:8], ctrlIDBytes(ShapeOLE))
+	buf = append(buf, makeRecord(TagShapeComponent, level+1, compData)...)
+
+	oleData := make([]byte, 24)
+	binary.LittleEndian.PutUint16(oleData[12:14], binDataID)
+	buf = append(buf, makeRecord(TagShapeOLE, level+2, oleData)...)
+	return buf
+}
+
+func TestSectionParser_OLE(t *testing.T) {
+	var data []byte
+	data = append(data, makeRecord(TagParaHeader, 0, makeParaHeader(0, 0))...)
+	data = append(data, makeRecord(TagParaText, 1, encodeParaText("\x0b", CtrlGSO))...)
+	data = append(data, makeOLERecords(1, 2)...)
+
+	section, err := NewSectionParser(&DocInfo{}).Parse(data)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(section.Blocks) != 1 || section.Blocks[0].Type != BlockOLE {
+		t.Fatalf("Expected a single OLE block, got %d blocks", len(section.Blocks))
+	}
+	object := section.Blocks[0].OLE
+	if object.BinDataID != 2 || object.Width != 14400 || object.Height != 7200 {
+		t.Errorf("Unexpected OLE object: %+v", object)
+	}
+}
+
+// makeLineSegs builds PARA_LINE_SEG data for lines starting at the given WCHAR positions.
+func makeLineSegs(starts []uint32, vertPos []int32) []byte {
+	var data []byte
+	for i, start := range starts {
+		line := make([]byte, 36)
+		binary.LittleEndian.PutUint32(line[0:4], start)
+		binary.LittleEndian.PutUint32(line[4:8], uint32(vertPos[i]))
+		data = append(data, line...)
+	}
+	return data
+}
+
+func TestSectionParser_PageLayout(t *testing.T) {
+	var data []byte
+
+	// 구역 정의와 가로 방향 A4 용지
+	data = append(data, makeRecord(TagParaHeader, 0, makeParaHeader(0, 0))...)
+	data = append(data, makeRecord(TagParaText, 1, encodeParaText("\x0b제목", CtrlSection))...)
+	data = append(data, makeRecord(TagParaLineSeg, 1, makeLineSegs([]uint32{0}, []int32{0}))...)
+	data = append(data, makeRecord(TagCtrlHeader, 1, ctrlIDBytes(CtrlSection))...)
+	pageDef := make([]byte, 40)
+	binary.LittleEndian.PutUint32(pageDef[0:4], 59528)
+	binary.LittleEndian.PutUint32(pageDef[4:8], 84186)
+	binary.LittleEndian.PutUint32(pageDef[8:12], 8504)
+	binary.LittleEndian.PutUint32(pageDef[36:40], 1)
+	data = append(data, makeRecord(TagPageDef, 2, pageDef)...)
+
+	// 2단 정의, 둘째 줄은 둘째 단에서 시작
+	data = append(data, makeRecord(TagParaHeader, 0, makeParaHeader(0, 0))...)
+	data = append(data, makeRecord(TagParaText, 1, encodeParaText("\x0b본문", CtrlColumn))...)
+	data = append(data, makeRecord(TagParaLineSeg, 1, makeLineSegs([]uint32{0, 9}, []int32{2000, 0}))...)
+	coldData := make([]byte, 8)
+	copy(coldData[0:4], ctrlIDBytes(CtrlColumn))
+	binary.LittleEndian.PutUint16(coldData[4:6], 2<<2)
+	binary.LittleEndian.PutUint16(coldData[6:8], 1134)
+	data = append(data, makeRecord(TagCtrlHeader, 1, coldData)...)
+
+	// 쪽 나누기
+	header := makeParaHeader(0, 0)
+	header[11] = DivisionPage
+	data = append(data, makeRecord(TagParaHeader, 0, header)...)
+	data = append(data, makeRecord(TagParaText, 1, encodeParaText("다음 쪽", ""))...)
+	data = append(data, makeRecord(TagParaLineSeg, 1, makeLineSegs([]uint32{0}, []int32{0}))...)
+
+	section, err := NewSectionParser(&DocInfo{}).Parse(data)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if section.PageDef == nil || !section.PageDef.IsLandscape() || section.PageDef.MarginLeft != 8504 {
+		t.Fatalf("unexpected page definition: %+v", section.PageDef)
+	}
+	if len(section.ColumnDefs) != 1 || section.ColumnDefs[0].Count != 2 || section.ColumnDefs[0].Block != 1 {
+		t.Fatalf("unexpected column definitions: %+v", section.ColumnDefs)
+	}
+	if len(section.Blocks) != 3 || !section.Blocks[2].PageBreak || len(section.Blocks[1].LineSegs) != 2 {
+		t.Fatalf("unexpected blocks: %+v", section.Blocks)
+	}
+	if offset := section.Blocks[1].LineSegs[1].Offset; offset != 1 {
+		t.Errorf("expected second line to start at rune 1, got %d", offset)
+	}
+
+	p := &Parser{docInfo: &DocInfo{}}
+	doc := ir.NewDocument()
+	p.convertSectionToIR(doc, section)
+
+	if len(doc.Sections) != 2 {
+		t.Fatalf("expected page section and column change, got %d sections", len(doc.Sections))
+	}
+	first := doc.Sections[0]
+	if first.Orientation != ir.OrientationLandscape || first.Width != 297 || first.Height != 210 || first.Margins.Left != 30 {
+		t.Errorf("unexpected section: %+v", first)
+	}
+	if second := doc.Sections[1]; second.Start != 1 || second.Columns != 2 || second.ColumnGap != 4 || second.Width != 297 {
+		t.Errorf("unexpected column section: %+v", second)
+	}
+
+	pages := []int{1, 1, 2}
+	for i, block := range doc.Content {
+		if block.Page != pages[i] {
+			t.Errorf("block %d: expected page %d, got %d", i, pages[i], block.Page)
+		}
+		if block.PageBreak != (i == 2) {
+			t.Errorf("block %d: unexpected page break %v", i, block.PageBreak)
+		}
+	}
+}
+
+// makeFormObject builds FORM_OBJECT data holding a form object property string.
+func makeFormObject(props string) []byte {
+	data := []byte("+tbc") // 개체 종류 ID
+	data = binary.LittleEndian.AppendUint16(data, uint16(len([]rune(props))))
+	for _, r := range props {
+		data = binary.LittleEndian.AppendUint16(data, uint16(r))
+	}
+	return data
+}
+
+func TestSectionParser_FormFields(t *testing.T) {
+	var data []byte
+
+	// 누름틀: 이름은 CTRL_DATA, 안내문은 명령의 Direction
+	data = append(data, makeRecord(TagParaHeader, 0, makeParaHeader(0, 0))...)
+	data = append(data, makeRecord(TagParaText, 1, encodeParaText("성명: \x03홍길동\x04", CtrlFieldClickHere))...)
+	data = append(data, makeRecord(TagCtrlHeader, 1, makeFieldCtrlHeader(CtrlFieldClickHere,
+		"Clickhere:set:44:Direction:wstring:10:이름을 입력하세요 HelpState:wstring:0: "))...)
+	data = append(data, makeRecord(TagCtrlData, 2, makeBookmarkData("성명"))...)
+
+	// 체크 박스
+	props := "CheckBtn set:120:CommonSet:set:40:Name:wstring:2:동의 ButtonSet:set:50:Caption:wstring:10:개인정보 수집 동의 Value:int:1 "
+	data = append(data, makeRecord(TagParaHeader, 0, makeParaHeader(0, 0))...)
+	data = append(data, makeRecord(TagParaText, 1, encodeParaText("\x0b", CtrlForm))...)
+	data = append(data, makeRecord(TagCtrlHeader, 1, ctrlIDBytes(CtrlForm))...)
+	data = append(data, makeRecord(TagCtrlFormField, 2, makeFormObject(props))...)
+
+	section, err := NewSectionParser(&DocInfo{}).Parse(data)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if len(section.Blocks) != 2 {
+		t.Fatalf("Expected 2 paragraph blocks, got %d", len(section.Blocks))
+	}
+	if f := section.Blocks[0].Paragraph.Fields; len(f) != 1 || !f[0].IsClickHere() || f[0].Name != "성명" {
+		t.Fatalf("Expected named click-here field, got %+v", f)
+	}
+	if forms := section.Blocks[1].Paragraph.Forms; len(forms) != 1 || forms[0].Type != ir.FormFieldCheckBox {
+		t.Fatalf("Expected check box, got %+v", forms)
+	}
+
+	p := &Parser{docInfo: &DocInfo{}}
+	doc := ir.NewDocument()
+	p.convertSectionToIR(doc, section)
+
+	if len(doc.Fields) != 2 {
+		t.Fatalf("Expected 2 form fields, got %d", len(doc.Fields))
+	}
+	clickHere := doc.Fields[0]
+	if clickHere.Value != "홍길동" || clickHere.Hint != "이름을 입력하세요" || clickHere.Block != 0 {
+		t.Errorf("unexpected click-here field: %+v", clickHere)
+	}
+	checkBox := doc.Fields[1]
+	if checkBox.Name != "동의" || !checkBox.Checked() || checkBox.Caption != "개인정보 수집 동의" || checkBox.Block != 1 {
+		t.Errorf("unexpected check box: %+v", checkBox)
+	}
+
+	// 값은 본문에도 남는다
+	if text := doc.Content[0].Paragraph.Text; text != "성명: 홍길동" {
+		t.Errorf("unexpected click-here paragraph: %q", text)
+	}
+	if text := doc.Content[1].Paragraph.Text; text != "☑ 개인정보 수집 동의" {
+		t.Errorf("unexpected form paragraph: %q", text)
+	}
+}
+
+func TestParser_ListFormFields(t *testing.T) {
+	var data []byte
+	data = append(data, makeRecord(TagParaHeader, 0, makeParaHeader(1, 0))...)
+	data = append(data, makeRecord(TagParaText, 1, encodeParaText("첫 목록", ""))...)
+
+	// 글머리표가 다른 목록을 시작하는 누름틀 문단
+	data = append(data, makeRecord(TagParaHeader, 0, makeParaHeader(2, 0))...)
+	data = append(data, makeRecord(TagParaText, 1, encodeParaText("성명: \x03홍길동\x04", CtrlFieldClickHere))...)
+	data = append(data, makeRecord(TagCtrlHeader, 1, makeFieldCtrlHeader(CtrlFieldClickHere, "Clickhere:set:0: "))...)
+	data = append(data, makeRecord(TagCtrlData, 2, makeBookmarkData("성명"))...)
+
+	docInfo := &DocInfo{ParaShapes: []*ParaShape{
+		{},
+		{Attributes1: ParaHeadBullet << 23, NumberingID: 1},
+		{Attributes1: ParaHeadBullet << 23, NumberingID: 2},
+	}}
+	section, err := NewSectionParser(docInfo).Parse(data)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	p := &Parser{docInfo: docInfo}
+	doc := ir.NewDocument()
+	p.convertSectionToIR(doc, section)
+
+	if len(doc.Content) != 2 || doc.Content[1].Type != ir.BlockTypeList {
+		t.Fatalf("Expected 2 list blocks, got %+v", doc.Content)
+	}
+	if len(doc.Fields) != 1 || doc.Fields[0].Block != 1 {
+		t.Errorf("Expected the field in the second list, got %+v", doc.Fields)
+	}
+}
+
+func TestParameterValue(t *testing.T) {
+	params := "CommonSet:set:60:Name:wstring:4:Edit GroupName:wstring:0: TabOrder:int:3 Text:wstring:5:서울 종로 "
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{"Name", "Edit"},
+		{"GroupName", ""},
+		{"TabOrder", "3"},
+		{"Text", "서울 종로"},
+		{"Missing", ""},
+	}
+	for _, tt := range tests {
+		if got := parameterValue(params, tt.name); got != tt.expected {
+			t.Errorf("parameterValue(%s) = %q, expected %q", tt.name, got, tt.expected)
+		}
+	}
+
+	if form := parseFormProperties("PushBtn set:10:Name:wstring:2:확인 "); form != nil {
+		t.Errorf("expected no field for a push button, got %+v", form)
+	}
+	if form := parseFormProperties(params); form != nil {
+		t.Errorf("expected no field without object type, got %+v", form)
+	}
+}
+
+func TestBinDataInfo_IsCompressed(t *testing.T) {
+	tests := []struct {
+		infoType      uint16
+		docCompressed bool
+		expected      bool
+	}{
+		{0x0001, true, true},   // 문서 설정을 따름
+		{0x0001, false, false}, // 문서 설정을 따름
+		{0x0011, false, true},  // 압축
+		{0x0021, true, false},  // 압축하지 않음
+	}
+
+	for _, tt := range tests {
+		info := &BinDataInfo{Type: tt.infoType}
+		if got := info.IsCompressed(tt.docCompressed); got != tt.expected {
+			t.Errorf("IsCompressed(type=0x%04X, doc=%v) = %v, expected %v", tt.infoType, tt.docCompressed, got, tt.expected)
+		}
+	}
+}
+
+// makeNumberingRecord builds NUMBERING data with the given level formats and start numbers.
+func makeNumberingRecord(formats [7]string, starts [7]uint32) []byte {
+	var data []byte
+	for _, format := range formats {
+		data = append(data, make([]byte, 12)...) // 문단 머리 정보
+		data = binary.LittleEndian.AppendUint16(data, uint16(len(format)))
+		for _, r := range format {
+			data = binary.LittleEndian.AppendUint16(data, uint16(r))
+		}
+	}
+	data = binary.LittleEndian.AppendUint16(data, 1)
+	for _, start := range starts {
+		data = binary.LittleEndian.AppendUint32(data, start)
+	}
+	return data
+}
+
+func TestParseNumbering(t *testing.T) {
+	data := makeNumberingRecord(
+		[7]string{"^1.", "^2.", "^3)", "", "", "", ""},
+		[7]uint32{1, 1, 5, 1, 1, 1, 1},
+	)
+
+	numbering := parseNumbering(data)
+	if numbering == nil {
+		t.Fatal("Expected numbering, got nil")
+	}
+	if numbering.Levels[0].Format != "^1." || numbering.Levels[2].Format != "^3)" {
+		t.Errorf("unexpected formats: %q, %q", numbering.Levels[0].Format, numbering.Levels[2].Format)
+	}
+	if numbering.Levels[2].Start != 5 {
+		t.Errorf("Expected level 3 start 5, got %d", numbering.Levels[2].Start)
+	}
+
+	bullet := parseBullet([]byte{0x08, 0, 0, 0, 0, 0, 0x1e, 0, 0x03, 0, 0, 0, 0x2d, 0x00})
+	if bullet == nil || bullet.Char != '-' {
+		t.Errorf("Expected bullet '-', got %+v", bullet)
+	}
+}
+
+func TestNumberingCounters(t *testing.T) {
+	numbering := &Numbering{}
+	for i := range numbering.Levels {
+		numbering.Levels[i].Start = 1
+	}
+	numbering.Levels[1].Start = 3
+
+	counters := make(parser.ListCounters)
+	steps := []struct {
+		level    int
+		expected int
+	}{
+		{0, 1},
+		{1, 3},
+		{1, 4},
+		{0, 2},
+		{1, 3}, // 상위 수준이 바뀌면 하위 수준은 다시 시작
+	}
+
+	for i, step := range steps {
+		if got := counters.Next(1, step.level, levelStart(numbering, step.level)); got != step.expected {
+			t.Errorf("step %d: expected %d, got %d", i, step.expected, got)
+		}
+	}
+}
+
+func TestParseBorderFill(t *testing.T) {
+	data := make([]byte, 48)
+	binary.LittleEndian.PutUint32(data[32:36], 1)          // 단색 채우기
+	binary.LittleEndian.PutUint32(data[36:40], 0x00CCDDEE) // COLORREF (BGR)
+	binary.LittleEndian.PutUint32(data[44:48], 0xFFFFFFFF)
+
+	bf := parseBorderFill(data)
+	if bf == nil {
+		t.Fatal("parseBorderFill returned nil")
+	}
+	if bf.Background() != "#EEDDCC" {
+		t.Errorf("Expected background #EEDDCC, got %q", bf.Background())
+	}
+
+	// 흰색과 채우기 없음은 배경으로 보지 않는다
+	binary.LittleEndian.PutUint32(data[36:40], 0x00FFFFFF)
+	if bg := parseBorderFill(data).Background(); bg != "" {
+		t.Errorf("Expected no background for white, got %q", bg)
+	}
+	binary.LittleEndian.PutUint32(data[32:36], 0)
+	if bg := parseBorderFill(data).Background(); bg != "" {
+		t.Errorf("Expected no background without solid fill, got %q", bg)
+	}
+}
+
+func TestSectionParser_CellSpans(t *testing.T) {
+	var data []byte
+	data = append(data, makeRecord(TagParaHeader, 0, makeParaHeader(0, 0))...)
+	data = append(data, makeRecord(TagParaText, 1, encodeParaText("\x0b", CtrlTable))...)
+	data = append(data, makeRecord(TagCtrlHeader, 1, append(ctrlIDBytes(CtrlTable), make([]byte, 40)...))...)
+	tableData := make([]byte, 18)
+	binary.LittleEndian.PutUint16(tableData[4:6], 3)
+	binary.LittleEndian.PutUint16(tableData[6:8], 3)
+	data = append(data, makeRecord(TagTable, 2, tableData)...)
+
+	// 첫 행은 세 칸을 합친 제목, 둘째·셋째 행의 첫 칸은 두 행을 합친 구분
+	cells := []struct {
+		col, row, colSpan, rowSpan uint16
+		text                       string
+	}{
+		{0, 0, 3, 1, "예산"},
+		{0, 1, 1, 2, "구분"},
+		{1, 1, 1, 1, "2024"},
+		{2, 1, 1, 1, "2025"},
+		{1, 2, 1, 1, "100"},
+		{2, 2, 1, 1, "120"},
+	}
+	for _, c := range cells {
+		data = append(data, makeRecord(TagListHeader, 2, makeCellListHeader(c.col, c.row, c.colSpan, c.rowSpan, 0))...)
+		data = append(data, makeRecord(TagParaHeader, 2, makeParaHeader(0, 0))...)
+		data = append(data, makeRecord(TagParaText, 3, encodeParaText(c.text, ""))...)
+	}
+
+	section, err := NewSectionParser(&DocInfo{}).Parse(data)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if len(section.Tables) != 1 {
+		t.Fatalf("Expected 1 table, got %d", len(section.Tables))
+	}
+
+	p := &Parser{docInfo: &DocInfo{}}
+	doc := ir.NewDocument()
+	p.convertSectionToIR(doc, section)
+
+	table := doc.Content[0].Table
+	if c := table.Cells[0][0]; c.Text != "예산" || c.ColSpan != 3 {
+		t.Errorf("unexpected merged title cell: %+v", c)
+	}
+	if c := table.Cells[1][0]; c.Text != "구분" || c.RowSpan != 2 {
+		t.Errorf("unexpected merged row cell: %+v", c)
+	}
+	// 병합으로 가려진 자리는 건너뛰고 다음 셀을 놓는다
+	if table.Cells[1][1].Text != "2024" || table.Cells[2][1].Text != "100" || table.Cells[2][2].Text != "120" {
+		t.Errorf("cells after merged cells are misplaced: %+v", table.Cells)
+	}
+}
+
+// makeCellListHeader builds the LIST_HEADER data of a table cell.
+func makeCellListHeader(col, row, colSpan, rowSpan, borderFill uint16) []byte {
+	data := make([]byte, 34)
+	binary.LittleEndian.PutUint16(data[0:2], 1)
+	binary.LittleEndian.PutUint16(data[8:10], col)
+	binary.LittleEndian.PutUint16(data[10:12], row)
+	binary.LittleEndian.PutUint16(data[12:14], colSpan)
+	binary.LittleEndian.PutUint16(data[14:16], rowSpan)
+	binary.LittleEndian.PutUint16(data[32:34], borderFill)
+	return data
+}
+
+func TestSectionParser_CellFormatting(t *testing.T) {
+	var data []byte
+	data = append(data, makeRecord(TagParaHeader, 0, makeParaHeader(0, 0))...)
+	data = append(data, makeRecord(TagParaText, 1, encodeParaText("\x0b", CtrlTable))...)
+	data = append(data, makeRecord(TagCtrlHeader, 1, append(ctrlIDBytes(CtrlTable), make([]byte, 40)...))...)
+	tableData := make([]byte, 18)
+	binary.LittleEndian.PutUint16(tableData[4:6], 2)
+	binary.LittleEndian.PutUint16(tableData[6:8], 2)
+	data = append(data, makeRecord(TagTable, 2, tableData)...)
+
+	// 첫 행은 회색 배경에 가운데 정렬, 둘째 행은 오른쪽 정렬
+	cells := []struct {
+		col, row, borderFill, paraShape uint16
+		text                            string
+	}{
+		{0, 0, 2, 1, "항목"},
+		{1, 0, 2, 1, "금액"},
+		{0, 1, 1, 0, "합계"},
+		{1, 1, 1, 2, "100"},
+	}
+	for _, c := range cells {
+		data = append(data, makeRecord(TagListHeader, 2, makeCellListHeader(c.col, c.row, 1, 1, c.borderFill))...)
+		data = append(data, makeRecord(TagParaHeader, 2, makeParaHeader(c.paraShape, 0))...)
+		data = append(data, makeRecord(TagParaText, 3, encodeParaText(c.text, ""))...)
+	}
+
+	docInfo := &DocInfo{
+		BorderFills: []*BorderFill{
+			{FillType: 0},
+			{FillType: 1, BackgroundColor: 0x00D9D9D9},
+		},
+		ParaShapes: []*ParaShape{
+			{Attributes1: 0 << 2},
+			{Attributes1: 3 << 2},
+			{Attributes1: 2 << 2},
+		},
+	}
+	section, err := NewSectionParser(docInfo).Parse(data)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if len(section.Tables) != 1 {
+		t.Fatalf("Expected 1 table, got %d", len(section.Tables))
+	}
+	if bf := section.Tables[0].Cells[0][1].BorderFill; bf != 2 {
+		t.Errorf("Expected border fill 2, got %d", bf)
+	}
+
+	p := &Parser{docInfo: docInfo}
+	doc := ir.NewDocument()
+	p.convertSectionToIR(doc, section)
+
+	table := doc.Content[0].Table
+	if !table.HasHeader {
+		t.Error("Expected the shaded first row to be a header row")
+	}
+	style := table.Cells[0][0].Style
+	if style.Background != "#D9D9D9" || style.Alignment != ir.AlignCenter {
+		t.Errorf("unexpected header cell style: %+v", style)
+	}
+	if got := table.Cells[1][1].Style.Alignment; got != ir.AlignRight {
+		t.Errorf("Expected right alignment, got %q", got)
+	}
+	if got := table.Cells[1][0].Style.Alignment; got != "" {
+		t.Errorf("Expected no alignment for justified text, got %q", got)
+	}
 }
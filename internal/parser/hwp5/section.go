@@ -3,14 +3,46 @@ package hwp5
 import (
 	"encoding/binary"
 	"fmt"
+	"math"
+	"sort"
 	"strings"
+
+	"github.com/roboco-io/hwp2md/internal/parser"
 )
 
 // Section은 본문 섹션 데이터
 type Section struct {
-	Paragraphs []*Paragraph
-	Tables     []*Table
-	Images     []*Image
+	Blocks        []*Block // 문서 순서대로 정렬된 블록
+	Paragraphs    []*Paragraph
+	Tables        []*Table
+	Images        []*Image
+	HeaderFooters []*HeaderFooter // 머리말/꼬리말/바탕쪽 (본문과 분리)
+	Memos         []*Memo         // 메모 내용 (메모 필드와 같은 순서)
+	PageDef       *PageDef        // 용지 설정 (구역 정의)
+	ColumnDefs    []*ColumnDef    // 단 정의 (적용되는 블록 순서)
+}
+
+// BlockType은 섹션 블록 종류
+type BlockType int
+
+const (
+	BlockParagraph BlockType = iota
+	BlockTable
+	BlockImage
+	BlockTextBox
+	BlockOLE
+)
+
+// Block은 섹션 내 하나의 블록 (문단, 표, 그림, 글상자, OLE 개체 중 하나)
+type Block struct {
+	Type      BlockType
+	Paragraph *Paragraph
+	Table     *Table
+	Image     *Image
+	TextBox   *TextBox
+	OLE       *OLEObject
+	PageBreak bool      // 쪽 나누기 뒤에 오는 블록
+	LineSegs  []LineSeg // 블록에 속한 줄의 배치 정보 (쪽 번호 추정에 사용)
 }
 
 // Paragraph는 문단 데이터
@@ -25,6 +57,71 @@ type Paragraph struct {
 	RangeTagCount  uint16
 	LineAlignCount uint16
 	InstanceID     uint32
+	CharShapes     []CharShapeRef // 글자 모양 구간 (PARA_CHAR_SHAPE)
+	Notes          []*Note        // 문단에 딸린 각주/미주
+	Fields         []*Field       // 문단 내 필드 (하이퍼링크 등)
+	Equations      []*Equation    // 문단 내 수식
+	Forms          []*FormObject  // 문단 내 양식 개체
+	Bookmarks      []*Bookmark    // 문단 내 책갈피
+	LineSegs       []LineSeg      // 줄 배치 정보 (PARA_LINE_SEG)
+
+	charPositions []int // 텍스트 룬별 PARA_TEXT 내 위치 (WCHAR 단위)
+}
+
+// CharShapeRef는 문단 내 글자 모양 구간의 시작 위치와 글자 모양 ID
+type CharShapeRef struct {
+	Position    uint32 // PARA_TEXT 내 시작 위치 (WCHAR 단위)
+	CharShapeID uint32
+}
+
+// TextRun은 같은 글자 모양이 적용된 연속 텍스트
+// Note, Equation, MemoEnd가 있으면 텍스트 대신 각주 참조, 수식, 메모의 위치를 나타낸다.
+type TextRun struct {
+	Text        string
+	CharShapeID uint32
+	Link        string // 하이퍼링크 필드 안의 텍스트이면 URL
+	Change      string // 교정 부호 구간 안의 텍스트이면 "insert" 또는 "delete"
+	ChangeID    uint32 // 교정 부호 필드의 ID (변경 내역 ID)
+	Memo        *Field // 메모를 붙인 구간 안의 텍스트이면 메모 필드
+	Note        *Note
+	Equation    *Equation
+	Form        *FormObject
+	MemoEnd     *Field // 메모를 붙인 구간의 끝 (메모 내용이 놓일 위치)
+}
+
+// isInline reports whether the run marks an inline object instead of text.
+func (r TextRun) isInline() bool {
+	return r.Note != nil || r.Equation != nil || r.Form != nil || r.MemoEnd != nil
+}
+
+// Note는 각주/미주 (CTRL_HEADER "fn  "/"en  ")
+type Note struct {
+	Endnote    bool
+	Offset     int // 문단 텍스트 내 참조 위치 (룬 단위, -1이면 문단 끝)
+	Paragraphs []*Paragraph
+}
+
+// HeaderFooterKind는 머리말/꼬리말/바탕쪽 구분
+type HeaderFooterKind int
+
+const (
+	KindHeader HeaderFooterKind = iota
+	KindFooter
+	KindMasterPage
+)
+
+// 머리말/꼬리말 적용 범위 (CTRL_HEADER 속성 bit 0-1)
+const (
+	ApplyBothPages = 0 // 양쪽
+	ApplyEvenPages = 1 // 짝수 쪽
+	ApplyOddPages  = 2 // 홀수 쪽
+)
+
+// HeaderFooter는 머리말/꼬리말 (CTRL_HEADER "head"/"foot") 또는 바탕쪽
+type HeaderFooter struct {
+	Kind       HeaderFooterKind
+	Apply      uint32 // 적용 범위 (ApplyBothPages 등)
+	Paragraphs []*Paragraph
 }
 
 // Table은 표 데이터
@@ -38,6 +135,7 @@ type Table struct {
 	RightMargin  int16
 	TopMargin    int16
 	BottomMargin int16
+	Caption      string // 캡션 텍스트
 }
 
 // TableCell은 표 셀 데이터
@@ -48,17 +146,27 @@ type TableCell struct {
 	ColSpan    int
 	Width      int
 	Height     int
+	BorderFill uint16 // 테두리/배경 ID (1부터 시작, 0이면 없음)
 	Paragraphs []*Paragraph
+	Images     []*Image     // 셀 안에 삽입된 그림
+	OLEs       []*OLEObject // 셀 안에 삽입된 OLE 개체
+	Tables     []*Table     // 셀 안에 삽입된 표 (중첩 표)
+
+	tableAnchors []int // 각 중첩 표 앞에 오는 문단 수 (Tables와 같은 순서)
+	imageAnchors []int // 각 그림 앞에 오는 문단 수 (Images와 같은 순서)
 }
 
 // Image는 이미지 데이터
 type Image struct {
 	BinDataID   uint16
 	BorderColor uint32
-	Width       int32
-	Height      int32
+	Width       int32 // HWPUNIT
+	Height      int32 // HWPUNIT
 	XOffset     int32
 	YOffset     int32
+	Crop        Crop
+	Description string // 개체 설명문
+	Caption     string // 캡션 텍스트
 }
 
 // SectionParser parses Section streams.
@@ -66,6 +174,10 @@ type SectionParser struct {
 	records       []*Record
 	textExtractor *TextExtractor
 	docInfo       *DocInfo
+	headerFooters []*HeaderFooter
+	pageDef       *PageDef
+	columnDefs    []*ColumnDef
+	numbers       *parser.AutoNumbers // 캡션의 그림/표/수식 번호 (문서 전체에서 이어진다)
 }
 
 // NewSectionParser creates a new section parser.
@@ -73,6 +185,7 @@ func NewSectionParser(docInfo *DocInfo) *SectionParser {
 	return &SectionParser{
 		textExtractor: NewTextExtractor(),
 		docInfo:       docInfo,
+		numbers:       docInfo.AutoNumbers(),
 	}
 }
 
@@ -85,6 +198,9 @@ func (sp *SectionParser) Parse(data []byte) (*Section, error) {
 	}
 
 	sp.records = records
+	sp.headerFooters = nil
+	sp.pageDef = nil
+	sp.columnDefs = nil
 	section := &Section{}
 
 	i := 0
@@ -95,89 +211,594 @@ func (sp *SectionParser) Parse(data []byte) (*Section, error) {
 		case TagParaHeader:
 			// Level 0 문단은 본문 문단
 			if rec.Level == 0 {
-				para, tables, nextIdx := sp.parseParagraphWithTables(i)
-				if para != nil && strings.TrimSpace(para.Text) != "" {
-					section.Paragraphs = append(section.Paragraphs, para)
+				columns := len(sp.columnDefs)
+				blocks, nextIdx := sp.parseParagraphBlocks(i)
+				// 문단에 있는 단 정의는 그 문단의 블록부터 적용된다
+				for _, def := range sp.columnDefs[columns:] {
+					def.Block = len(section.Blocks)
+				}
+				for _, block := range blocks {
+					section.addBlock(block)
 				}
-				// 문단 내에서 발견된 테이블 추가
-				section.Tables = append(section.Tables, tables...)
 				i = nextIdx
 				continue
 			}
 
 		case TagCtrlHeader:
-			// Level 0 또는 1인 CTRL_HEADER만 본문 레벨 테이블로 처리
-			// (테이블 내 중첩 테이블은 Level이 더 높음)
-			if rec.Level <= 1 && len(rec.Data) >= 4 {
-				ctrlID := string(rec.Data[0:4])
-				// " lbt" 또는 "tbl " = 테이블
-				if ctrlID == " lbt" || ctrlID == "tbl " {
-					table, nextIdx := sp.parseTableBlock(i)
-					if table != nil && table.Rows > 0 && table.Cols > 0 {
-						section.Tables = append(section.Tables, table)
-					}
-					i = nextIdx
+			// 문단에 속하지 않은 컨트롤 (비정상 스트림 대비)
+			if rec.Level <= 1 {
+				if sp.parseHeaderFooter(i) {
+					i = sp.subtreeEnd(i)
 					continue
 				}
+				block, nextIdx := sp.parseControl(i)
+				if block != nil {
+					section.addBlock(block)
+				}
+				i = nextIdx
+				continue
 			}
+
+		case TagMemoList:
+			memo, nextIdx := sp.parseMemo(i)
+			section.Memos = append(section.Memos, memo)
+			i = nextIdx
+			continue
 		}
 
 		i++
 	}
 
+	section.HeaderFooters = sp.headerFooters
+	section.PageDef = sp.pageDef
+	section.ColumnDefs = sp.columnDefs
 	return section, nil
 }
 
-// parseParagraphWithTables parses a paragraph starting at index i and collects any tables within it.
-// Returns the paragraph, any tables found, and the next index to process.
-func (sp *SectionParser) parseParagraphWithTables(startIdx int) (*Paragraph, []*Table, int) {
+// addBlock appends a block to the ordered stream and the per-type lists.
+func (s *Section) addBlock(block *Block) {
+	s.Blocks = append(s.Blocks, block)
+	switch block.Type {
+	case BlockParagraph:
+		s.Paragraphs = append(s.Paragraphs, block.Paragraph)
+	case BlockTable:
+		s.Tables = append(s.Tables, block.Table)
+	case BlockImage:
+		s.Images = append(s.Images, block.Image)
+	}
+}
+
+// anchoredBlock은 문단 내 컨트롤 위치에 고정된 블록
+type anchoredBlock struct {
+	offset int // 문단 텍스트 내 위치 (-1이면 문단 끝)
+	block  *Block
+}
+
+// parseParagraphBlocks parses a paragraph starting at index i and returns its blocks in reading order.
+// 표나 그림은 PARA_TEXT 내 컨트롤 문자 위치에 배치되며, 그 앞뒤 텍스트는 별도 문단 블록이 된다.
+func (sp *SectionParser) parseParagraphBlocks(startIdx int) ([]*Block, int) {
 	if startIdx >= len(sp.records) {
-		return nil, nil, startIdx + 1
+		return nil, startIdx + 1
 	}
 
 	rec := sp.records[startIdx]
 	if rec.TagID != TagParaHeader {
-		return nil, nil, startIdx + 1
+		return nil, startIdx + 1
 	}
 
 	para := sp.parseParaHeader(rec.Data)
 	startLevel := rec.Level
 	i := startIdx + 1
-	var tables []*Table
 
-	// 다음 PARA_TEXT 찾기
+	var anchored []anchoredBlock
+	ctrlIndex := 0 // 지금까지 만난 확장 컨트롤 개수
+
 	for i < len(sp.records) {
 		nextRec := sp.records[i]
 
-		// 같은 레벨 이하의 다른 PARA_HEADER가 나오면 종료
-		if nextRec.TagID == TagParaHeader && nextRec.Level <= startLevel {
+		// 같은 레벨 이하의 레코드가 나오면 문단 종료
+		if nextRec.Level <= startLevel {
 			break
 		}
 
 		// PARA_TEXT 처리
 		if nextRec.TagID == TagParaText {
-			text, controls := sp.textExtractor.ExtractTextWithControls(nextRec.Data)
+			text, controls, positions := sp.textExtractor.ExtractTextWithPositions(nextRec.Data)
 			para.Text = text
 			para.Controls = controls
+			para.charPositions = positions
 		}
 
-		// 테이블 컨트롤 처리 (문단 내 삽입된 테이블)
-		if nextRec.TagID == TagCtrlHeader && len(nextRec.Data) >= 4 {
-			ctrlID := string(nextRec.Data[0:4])
-			if ctrlID == " lbt" || ctrlID == "tbl " {
-				table, nextIdx := sp.parseTableBlock(i)
-				if table != nil && table.Rows > 0 && table.Cols > 0 {
-					tables = append(tables, table)
-				}
-				i = nextIdx
+		// 글자 모양 구간
+		if nextRec.TagID == TagParaCharShape && nextRec.Level == startLevel+1 {
+			para.CharShapes = parseParaCharShape(nextRec.Data)
+		}
+
+		// 줄 배치 정보 (쪽 번호 추정에 사용)
+		if nextRec.TagID == TagParaLineSeg && nextRec.Level == startLevel+1 {
+			para.LineSegs = parseLineSegs(nextRec.Data)
+		}
+
+		// 문단에 딸린 컨트롤 (표, 그리기 개체 등)
+		if nextRec.TagID == TagCtrlHeader && nextRec.Level == startLevel+1 {
+			offset := para.extendedControlOffset(ctrlIndex)
+			ctrlIndex++
+
+			// 책갈피는 이름과 위치만 기록 (구간 책갈피는 필드로도 처리)
+			if name := sp.parseBookmark(i); name != "" {
+				para.Bookmarks = append(para.Bookmarks, &Bookmark{Name: name, Offset: offset})
+			}
+
+			// 각주/미주는 문단을 나누지 않고 참조 위치만 기록
+			if note := sp.parseNote(i, offset); note != nil {
+				para.Notes = append(para.Notes, note)
+				i = sp.subtreeEnd(i)
 				continue
 			}
+
+			// 자동 번호는 번호를 문단 텍스트에 넣는다
+			if sp.parseAutoNumber(para, i, ctrlIndex-1) {
+				i = sp.subtreeEnd(i)
+				continue
+			}
+
+			// 구역 정의와 단 정의는 쪽 모양으로 기록한다 (구역 정의의 바탕쪽 포함)
+			if sp.parseLayoutControl(i) {
+				i = sp.subtreeEnd(i)
+				continue
+			}
+
+			// 머리말/꼬리말은 본문과 분리해서 모은다
+			if sp.parseHeaderFooter(i) {
+				i = sp.subtreeEnd(i)
+				continue
+			}
+
+			// 필드는 텍스트 구간만 기록
+			if field := sp.parseField(i, offset); field != nil {
+				para.Fields = append(para.Fields, field)
+				i = sp.subtreeEnd(i)
+				continue
+			}
+
+			// 수식은 글자처럼 문단 안에 둔다
+			if eq := sp.parseEquation(i, offset); eq != nil {
+				para.Equations = append(para.Equations, eq)
+				i = sp.subtreeEnd(i)
+				continue
+			}
+
+			// 양식 개체도 값을 글자처럼 문단 안에 둔다
+			if form := sp.parseForm(i, offset); form != nil {
+				para.Forms = append(para.Forms, form)
+				i = sp.subtreeEnd(i)
+				continue
+			}
+
+			block, nextIdx := sp.parseControl(i)
+			if block != nil {
+				anchored = append(anchored, anchoredBlock{offset: offset, block: block})
+			}
+			i = nextIdx
+			continue
 		}
 
 		i++
 	}
 
-	return para, tables, i
+	para.resolveFieldEnds()
+	para.resolveLineOffsets()
+	return splitParagraph(para, anchored), i
+}
+
+// extendedControlOffset returns the text offset of the n-th extended control, or -1 if unknown.
+func (p *Paragraph) extendedControlOffset(n int) int {
+	if i := p.extendedControlIndex(n); i >= 0 {
+		return p.Controls[i].Offset
+	}
+	return -1
+}
+
+// extendedControlIndex returns the index in p.Controls of the n-th extended control, or -1 if unknown.
+func (p *Paragraph) extendedControlIndex(n int) int {
+	count := 0
+	for i, ctrl := range p.Controls {
+		if !ctrl.IsExtended() {
+			continue
+		}
+		if count == n {
+			return i
+		}
+		count++
+	}
+	return -1
+}
+
+// splitParagraph splits a paragraph around its anchored blocks, preserving reading order.
+// 줄 배치 정보는 줄이 시작하는 위치의 블록에 나누어 두고, 버려지는 빈 조각의 줄은 다음 블록에 붙인다.
+func splitParagraph(para *Paragraph, anchored []anchoredBlock) []*Block {
+	var blocks []*Block
+	runes := []rune(para.Text)
+	pos := 0
+
+	var pendingLines []LineSeg
+	nextLine := 0
+	// takeLines returns the lines starting before end that are not yet assigned.
+	takeLines := func(end int) []LineSeg {
+		first := nextLine
+		for nextLine < len(para.LineSegs) && para.LineSegs[nextLine].Offset < end {
+			nextLine++
+		}
+		return append(pendingLines, para.LineSegs[first:nextLine]...)
+	}
+	addBlock := func(block *Block, lines []LineSeg) {
+		block.LineSegs = lines
+		pendingLines = nil
+		blocks = append(blocks, block)
+	}
+
+	emitText := func(end int) {
+		if end > len(runes) {
+			end = len(runes)
+		}
+		if end <= pos {
+			return
+		}
+		part := para.slice(pos, end)
+		lines := takeLines(end)
+		if strings.TrimSpace(part.Text) != "" || len(part.Equations) > 0 || len(part.Forms) > 0 || len(part.Bookmarks) > 0 {
+			addBlock(&Block{Type: BlockParagraph, Paragraph: part}, lines)
+		} else {
+			pendingLines = lines
+		}
+		pos = end
+	}
+
+	for _, a := range anchored {
+		if a.offset >= 0 {
+			emitText(a.offset)
+			addBlock(a.block, takeLines(a.offset+1))
+		} else {
+			addBlock(a.block, takeLines(0))
+		}
+	}
+	if len(runes) == 0 && (len(para.Equations) > 0 || len(para.Forms) > 0 || len(para.Bookmarks) > 0) {
+		// 수식, 양식 개체나 책갈피만 있는 문단
+		addBlock(&Block{Type: BlockParagraph, Paragraph: para}, takeLines(1))
+	}
+	emitText(len(runes))
+
+	if len(blocks) > 0 {
+		last := blocks[len(blocks)-1]
+		last.LineSegs = append(last.LineSegs, takeLines(math.MaxInt)...)
+		if para.DivisionType&DivisionPage != 0 {
+			blocks[0].PageBreak = true
+		}
+	}
+	return blocks
+}
+
+// slice returns a copy of the paragraph limited to the text range [start, end) in runes.
+func (p *Paragraph) slice(start, end int) *Paragraph {
+	runes := []rune(p.Text)
+	part := *p
+	part.Text = string(runes[start:end])
+	if len(p.charPositions) == len(runes) {
+		part.charPositions = p.charPositions[start:end]
+	} else {
+		part.charPositions = nil
+	}
+	part.Controls = nil
+	for _, ctrl := range p.Controls {
+		if ctrl.Offset >= start && ctrl.Offset < end {
+			ctrl.Offset -= start
+			part.Controls = append(part.Controls, ctrl)
+		}
+	}
+
+	// 조각과 겹치는 필드만 남기고 위치를 옮긴다
+	part.Fields = nil
+	for _, field := range p.Fields {
+		fieldEnd := field.End
+		if fieldEnd < 0 {
+			fieldEnd = len(runes)
+		}
+		if fieldEnd <= start || field.Start >= end {
+			continue
+		}
+		rebased := *field
+		rebased.Start = max(field.Start, start) - start
+		rebased.End = min(fieldEnd, end) - start
+		part.Fields = append(part.Fields, &rebased)
+	}
+
+	// 문단 끝에 붙은 각주는 마지막 조각에 포함
+	isLast := end == len(runes)
+	part.Notes = nil
+	for _, note := range p.Notes {
+		inRange := note.Offset >= start && note.Offset < end
+		atEnd := isLast && (note.Offset < 0 || note.Offset >= end)
+		if inRange || atEnd {
+			rebased := *note
+			if rebased.Offset >= 0 {
+				rebased.Offset -= start
+			}
+			part.Notes = append(part.Notes, &rebased)
+		}
+	}
+
+	part.Equations = nil
+	for _, eq := range p.Equations {
+		inRange := eq.Offset >= start && eq.Offset < end
+		atEnd := isLast && (eq.Offset < 0 || eq.Offset >= end)
+		if inRange || atEnd {
+			rebased := *eq
+			if rebased.Offset >= 0 {
+				rebased.Offset -= start
+			}
+			part.Equations = append(part.Equations, &rebased)
+		}
+	}
+
+	part.Forms = nil
+	for _, form := range p.Forms {
+		inRange := form.Offset >= start && form.Offset < end
+		atEnd := isLast && (form.Offset < 0 || form.Offset >= end)
+		if inRange || atEnd {
+			rebased := *form
+			if rebased.Offset >= 0 {
+				rebased.Offset -= start
+			}
+			part.Forms = append(part.Forms, &rebased)
+		}
+	}
+
+	part.Bookmarks = nil
+	for _, bm := range p.Bookmarks {
+		inRange := bm.Offset >= start && bm.Offset < end
+		atEnd := isLast && (bm.Offset < 0 || bm.Offset >= end)
+		if inRange || atEnd {
+			rebased := *bm
+			if rebased.Offset >= 0 {
+				rebased.Offset -= start
+			}
+			part.Bookmarks = append(part.Bookmarks, &rebased)
+		}
+	}
+
+	// 줄 배치는 splitParagraph가 블록별로 나눈다
+	part.LineSegs = nil
+	return &part
+}
+
+// parseNote parses a footnote/endnote control starting at the CTRL_HEADER record.
+// Returns nil if the control is not a note.
+func (sp *SectionParser) parseNote(startIdx int, offset int) *Note {
+	ctrlRec := sp.records[startIdx]
+
+	var note *Note
+	switch parseCtrlID(ctrlRec.Data) {
+	case CtrlFootnote:
+		note = &Note{Offset: offset}
+	case CtrlEndnote:
+		note = &Note{Endnote: true, Offset: offset}
+	default:
+		return nil
+	}
+
+	// 본문은 컨트롤 바로 아래의 LIST_HEADER에 이어지는 문단들
+	end := sp.subtreeEnd(startIdx)
+	for i := startIdx + 1; i < end; i++ {
+		rec := sp.records[i]
+		if rec.TagID == TagListHeader && rec.Level == ctrlRec.Level+1 {
+			note.Paragraphs, _ = sp.parseParagraphList(i)
+			break
+		}
+	}
+
+	return note
+}
+
+// parseHeaderFooter collects header/footer text from a "head"/"foot" control,
+// and master pages from a section definition ("secd") control.
+// Returns false if the control is neither.
+func (sp *SectionParser) parseHeaderFooter(startIdx int) bool {
+	ctrlRec := sp.records[startIdx]
+
+	var kind HeaderFooterKind
+	switch parseCtrlID(ctrlRec.Data) {
+	case CtrlHeader:
+		kind = KindHeader
+	case CtrlFooter:
+		kind = KindFooter
+	case CtrlSection:
+		kind = KindMasterPage
+	default:
+		return false
+	}
+
+	// 속성 bit 0-1: 적용 범위 (바탕쪽은 구분하지 않음)
+	var apply uint32
+	if kind != KindMasterPage && len(ctrlRec.Data) >= 8 {
+		apply = binary.LittleEndian.Uint32(ctrlRec.Data[4:8]) & 0x03
+	}
+
+	// 본문은 컨트롤 바로 아래의 LIST_HEADER에 이어지는 문단들
+	// 구역 정의 아래의 LIST_HEADER는 바탕쪽이다
+	end := sp.subtreeEnd(startIdx)
+	for i := startIdx + 1; i < end; i++ {
+		rec := sp.records[i]
+		if rec.TagID != TagListHeader || rec.Level != ctrlRec.Level+1 {
+			continue
+		}
+		paragraphs, _ := sp.parseParagraphList(i)
+		sp.headerFooters = append(sp.headerFooters, &HeaderFooter{
+			Kind:       kind,
+			Apply:      apply,
+			Paragraphs: paragraphs,
+		})
+		if kind != KindMasterPage {
+			break
+		}
+	}
+
+	return true
+}
+
+// parseParaCharShape parses a PARA_CHAR_SHAPE record into (position, char shape ID) pairs.
+func parseParaCharShape(data []byte) []CharShapeRef {
+	var refs []CharShapeRef
+	for offset := 0; offset+8 <= len(data); offset += 8 {
+		refs = append(refs, CharShapeRef{
+			Position:    binary.LittleEndian.Uint32(data[offset : offset+4]),
+			CharShapeID: binary.LittleEndian.Uint32(data[offset+4 : offset+8]),
+		})
+	}
+	return refs
+}
+
+// TextRuns splits the paragraph text into runs sharing the same char shape.
+// 각주/미주 참조, 수식과 양식 개체 위치에는 Note, Equation이나 Form이 설정된 빈 구간이 들어간다.
+// 위치 정보가 없으면 문단 전체를 첫 번째 글자 모양의 한 구간으로 본다.
+func (p *Paragraph) TextRuns() []TextRun {
+	runes := []rune(p.Text)
+	if len(runes) == 0 && len(p.Notes) == 0 && len(p.Equations) == 0 && len(p.Forms) == 0 {
+		return nil
+	}
+
+	hasPositions := len(p.CharShapes) > 0 && len(p.charPositions) == len(runes)
+	defaultID := uint32(p.CharShapeID)
+	if len(p.CharShapes) > 0 {
+		defaultID = p.CharShapes[0].CharShapeID
+	}
+
+	var runs []TextRun
+	shapeIdx := 0
+	noteIdx := 0
+	eqIdx := 0
+	formIdx := 0
+
+	// offset 이전에 놓인 각주 참조, 수식, 양식 개체, 메모 구간의 끝을 내보낸다
+	var memoEnds []*Field
+	for _, f := range p.Fields {
+		if f.IsMemo() {
+			memoEnds = append(memoEnds, f)
+		}
+	}
+	memoEnd := func(f *Field) int {
+		if f.End < 0 {
+			return len(runes)
+		}
+		return f.End
+	}
+	sort.SliceStable(memoEnds, func(a, b int) bool { return memoEnd(memoEnds[a]) < memoEnd(memoEnds[b]) })
+	emitNotes := func(offset int) {
+		for len(memoEnds) > 0 {
+			if memoEnd(memoEnds[0]) > offset {
+				break
+			}
+			runs = append(runs, TextRun{MemoEnd: memoEnds[0]})
+			memoEnds = memoEnds[1:]
+		}
+		for noteIdx < len(p.Notes) {
+			note := p.Notes[noteIdx]
+			noteOffset := note.Offset
+			if noteOffset < 0 {
+				noteOffset = len(runes)
+			}
+			if noteOffset > offset {
+				break
+			}
+			runs = append(runs, TextRun{Note: note})
+			noteIdx++
+		}
+		for eqIdx < len(p.Equations) {
+			eq := p.Equations[eqIdx]
+			eqOffset := eq.Offset
+			if eqOffset < 0 {
+				eqOffset = len(runes)
+			}
+			if eqOffset > offset {
+				break
+			}
+			runs = append(runs, TextRun{Equation: eq})
+			eqIdx++
+		}
+		for formIdx < len(p.Forms) {
+			form := p.Forms[formIdx]
+			formOffset := form.Offset
+			if formOffset < 0 {
+				formOffset = len(runes)
+			}
+			if formOffset > offset {
+				break
+			}
+			runs = append(runs, TextRun{Form: form})
+			formIdx++
+		}
+	}
+
+	for k, r := range runes {
+		emitNotes(k)
+
+		id := defaultID
+		if hasPositions {
+			// 현재 글자 위치에 적용되는 마지막 구간 찾기
+			for shapeIdx+1 < len(p.CharShapes) && int(p.CharShapes[shapeIdx+1].Position) <= p.charPositions[k] {
+				shapeIdx++
+			}
+			id = p.CharShapes[shapeIdx].CharShapeID
+		}
+
+		link := p.linkAt(k)
+		change, changeID := p.changeAt(k)
+		memo := p.memoAt(k)
+		if n := len(runs); n == 0 || runs[n-1].isInline() || runs[n-1].CharShapeID != id || runs[n-1].Link != link ||
+			runs[n-1].Change != change || runs[n-1].ChangeID != changeID || runs[n-1].Memo != memo {
+			runs = append(runs, TextRun{CharShapeID: id, Link: link, Change: change, ChangeID: changeID, Memo: memo})
+		}
+		runs[len(runs)-1].Text += string(r)
+	}
+	emitNotes(len(runes))
+
+	return runs
+}
+
+// parseControl parses the control starting at a CTRL_HEADER record.
+// Returns the resulting block (nil if the control produces no content) and the next index.
+func (sp *SectionParser) parseControl(startIdx int) (*Block, int) {
+	ctrlRec := sp.records[startIdx]
+
+	switch parseCtrlID(ctrlRec.Data) {
+	case CtrlTable:
+		table, _ := sp.parseTableBlock(startIdx)
+		if table != nil && table.Rows > 0 && table.Cols > 0 {
+			return &Block{Type: BlockTable, Table: table}, sp.subtreeEnd(startIdx)
+		}
+
+	case CtrlGSO:
+		if img := sp.parsePicture(startIdx); img != nil {
+			return &Block{Type: BlockImage, Image: img}, sp.subtreeEnd(startIdx)
+		}
+		if ole := sp.parseOLE(startIdx); ole != nil {
+			return &Block{Type: BlockOLE, OLE: ole}, sp.subtreeEnd(startIdx)
+		}
+		if textBox := sp.parseTextBox(startIdx); textBox != nil {
+			return &Block{Type: BlockTextBox, TextBox: textBox}, sp.subtreeEnd(startIdx)
+		}
+	}
+
+	return nil, sp.subtreeEnd(startIdx)
+}
+
+// subtreeEnd returns the index just past the record at startIdx and all of its descendants.
+func (sp *SectionParser) subtreeEnd(startIdx int) int {
+	level := sp.records[startIdx].Level
+	i := startIdx + 1
+	for i < len(sp.records) && sp.records[i].Level > level {
+		i++
+	}
+	return i
 }
 
 // parseTableBlock parses a table block starting at the CTRL_HEADER for the table.
@@ -197,6 +818,7 @@ func (sp *SectionParser) parseTableBlock(startIdx int) (*Table, int) {
 
 	var table *Table
 	var cells []*TableCell
+	var caption string
 
 	// 테이블 내 레코드 처리
 	for i < len(sp.records) {
@@ -217,6 +839,14 @@ func (sp *SectionParser) parseTableBlock(startIdx int) (*Table, int) {
 			table = sp.parseTableRecord(rec.Data)
 
 		case TagListHeader:
+			// TABLE 레코드보다 앞서 표에 직접 딸린 LIST_HEADER는 캡션
+			if table == nil && rec.Level == tableLevel+1 {
+				paragraphs, nextIdx := sp.parseParagraphList(i)
+				caption = joinParagraphText(paragraphs)
+				i = nextIdx
+				continue
+			}
+
 			// LIST_HEADER는 셀을 나타냄
 			// 이 셀의 문단들을 수집
 			cell, nextIdx := sp.parseCellBlock(i, tableLevel)
@@ -234,10 +864,32 @@ func (sp *SectionParser) parseTableBlock(startIdx int) (*Table, int) {
 	if table != nil && len(cells) > 0 {
 		sp.arrangeCellsInTable(table, cells)
 	}
+	if table != nil {
+		table.Caption = caption
+	}
 
 	return table, i
 }
 
+// parseCellProperties parses the cell properties following the list header of a cell.
+// The merge counts are kept as the cell spans, so arrangeCellsInTable skips the positions
+// covered by a merged cell and the IR table keeps the merge.
+// [0:2] 문단 수, [2:4] 예약, [4:8] 속성, [8:10] 열 주소, [10:12] 행 주소,
+// [12:14] 열 병합 개수, [14:16] 행 병합 개수, [16:20] 폭, [20:24] 높이, [24:32] 안쪽 여백, [32:34] 테두리/배경 ID
+func parseCellProperties(data []byte) *TableCell {
+	cell := &TableCell{ColSpan: 1, RowSpan: 1}
+	if len(data) < 34 {
+		return cell
+	}
+
+	cell.ColSpan = max(int(binary.LittleEndian.Uint16(data[12:14])), 1)
+	cell.RowSpan = max(int(binary.LittleEndian.Uint16(data[14:16])), 1)
+	cell.Width = int(binary.LittleEndian.Uint32(data[16:20]))
+	cell.Height = int(binary.LittleEndian.Uint32(data[20:24]))
+	cell.BorderFill = binary.LittleEndian.Uint16(data[32:34])
+	return cell
+}
+
 // parseCellBlock parses a cell starting at LIST_HEADER.
 func (sp *SectionParser) parseCellBlock(startIdx int, tableLevel uint16) (*TableCell, int) {
 	if startIdx >= len(sp.records) {
@@ -250,10 +902,7 @@ func (sp *SectionParser) parseCellBlock(startIdx int, tableLevel uint16) (*Table
 	}
 
 	cellLevel := listRec.Level
-	cell := &TableCell{
-		ColSpan: 1,
-		RowSpan: 1,
-	}
+	cell := parseCellProperties(listRec.Data)
 
 	i := startIdx + 1
 	var paragraphs []*Paragraph
@@ -275,10 +924,23 @@ func (sp *SectionParser) parseCellBlock(startIdx int, tableLevel uint16) (*Table
 		switch rec.TagID {
 		case TagParaHeader:
 			// 셀 내 문단
-			para, nextIdx := sp.parseCellParagraph(i, cellLevel)
+			para, objects, nextIdx := sp.parseCellParagraph(i, cellLevel)
 			if para != nil {
 				paragraphs = append(paragraphs, para)
 			}
+			for _, img := range objects.images {
+				cell.Images = append(cell.Images, img)
+				cell.imageAnchors = append(cell.imageAnchors, len(paragraphs))
+			}
+			cell.OLEs = append(cell.OLEs, objects.oles...)
+			for _, table := range objects.tables {
+				cell.Tables = append(cell.Tables, table)
+				cell.tableAnchors = append(cell.tableAnchors, len(paragraphs))
+			}
+			// 글상자 문단은 셀 문단에 이어 붙인다
+			for _, textBox := range objects.textBoxes {
+				paragraphs = append(paragraphs, textBox.Paragraphs...)
+			}
 			i = nextIdx
 			continue
 		}
@@ -290,20 +952,31 @@ func (sp *SectionParser) parseCellBlock(startIdx int, tableLevel uint16) (*Table
 	return cell, i
 }
 
+// cellObjects는 셀 문단에 놓인 개체들
+type cellObjects struct {
+	images    []*Image
+	oles      []*OLEObject
+	tables    []*Table
+	textBoxes []*TextBox
+}
+
 // parseCellParagraph parses a paragraph within a cell.
-func (sp *SectionParser) parseCellParagraph(startIdx int, cellLevel uint16) (*Paragraph, int) {
+// Returns the paragraph, the objects (pictures, OLE objects, tables, text boxes) anchored in it, and the next index to process.
+func (sp *SectionParser) parseCellParagraph(startIdx int, cellLevel uint16) (*Paragraph, cellObjects, int) {
+	var objects cellObjects
 	if startIdx >= len(sp.records) {
-		return nil, startIdx + 1
+		return nil, objects, startIdx + 1
 	}
 
 	rec := sp.records[startIdx]
 	if rec.TagID != TagParaHeader {
-		return nil, startIdx + 1
+		return nil, objects, startIdx + 1
 	}
 
 	para := sp.parseParaHeader(rec.Data)
 	paraLevel := rec.Level
 	i := startIdx + 1
+	ctrlIndex := 0 // 지금까지 만난 확장 컨트롤 개수
 
 	// 문단 관련 레코드 처리
 	for i < len(sp.records) {
@@ -326,10 +999,70 @@ func (sp *SectionParser) parseCellParagraph(startIdx int, cellLevel uint16) (*Pa
 			para.Controls = controls
 		}
 
+		// 글자 모양 구간 (셀 글자가 모두 진한지 판단하는 데 쓴다)
+		if nextRec.TagID == TagParaCharShape && nextRec.Level == paraLevel+1 {
+			para.CharShapes = parseParaCharShape(nextRec.Data)
+		}
+
+		// 셀 문단의 각주/미주 - 본문 문단이 셀 텍스트를 덮어쓰지 않도록 하위 레코드를 건너뛴다
+		if nextRec.TagID == TagCtrlHeader && nextRec.Level == paraLevel+1 {
+			offset := para.extendedControlOffset(ctrlIndex)
+			ctrlIndex++
+
+			if name := sp.parseBookmark(i); name != "" {
+				para.Bookmarks = append(para.Bookmarks, &Bookmark{Name: name, Offset: offset})
+			}
+			if note := sp.parseNote(i, offset); note != nil {
+				para.Notes = append(para.Notes, note)
+				i = sp.subtreeEnd(i)
+				continue
+			}
+			if sp.parseAutoNumber(para, i, ctrlIndex-1) {
+				i = sp.subtreeEnd(i)
+				continue
+			}
+			if field := sp.parseField(i, offset); field != nil {
+				para.Fields = append(para.Fields, field)
+			}
+			if eq := sp.parseEquation(i, offset); eq != nil {
+				para.Equations = append(para.Equations, eq)
+				i = sp.subtreeEnd(i)
+				continue
+			}
+			if form := sp.parseForm(i, offset); form != nil {
+				para.Forms = append(para.Forms, form)
+				i = sp.subtreeEnd(i)
+				continue
+			}
+
+			// 중첩 표 - 하위 셀 문단이 이 문단의 텍스트를 덮어쓰지 않도록 통째로 파싱한다
+			if parseCtrlID(nextRec.Data) == CtrlTable {
+				if table, _ := sp.parseTableBlock(i); table != nil && table.Rows > 0 && table.Cols > 0 {
+					objects.tables = append(objects.tables, table)
+				}
+				i = sp.subtreeEnd(i)
+				continue
+			}
+
+			// 셀 문단에 삽입된 그림, OLE 개체나 글상자
+			if parseCtrlID(nextRec.Data) == CtrlGSO {
+				if img := sp.parsePicture(i); img != nil {
+					objects.images = append(objects.images, img)
+				} else if ole := sp.parseOLE(i); ole != nil {
+					objects.oles = append(objects.oles, ole)
+				} else if textBox := sp.parseTextBox(i); textBox != nil {
+					objects.textBoxes = append(objects.textBoxes, textBox)
+				}
+				i = sp.subtreeEnd(i)
+				continue
+			}
+		}
+
 		i++
 	}
 
-	return para, i
+	para.resolveFieldEnds()
+	return para, objects, i
 }
 
 // arrangeCellsInTable arranges cells into the table's 2D grid.
@@ -384,14 +1117,15 @@ func (sp *SectionParser) parseParaHeader(data []byte) *Paragraph {
 		return &Paragraph{}
 	}
 
+	// [0:4] 글자 수, [4:8] 컨트롤 마스크
 	para := &Paragraph{
-		ParaShapeID:    binary.LittleEndian.Uint16(data[4:6]),
-		StyleID:        uint16(data[6]),
-		DivisionType:   data[7],
-		CharShapeCount: binary.LittleEndian.Uint16(data[8:10]),
-		RangeTagCount:  binary.LittleEndian.Uint16(data[10:12]),
-		LineAlignCount: binary.LittleEndian.Uint16(data[12:14]),
-		InstanceID:     binary.LittleEndian.Uint32(data[14:18]),
+		ParaShapeID:    binary.LittleEndian.Uint16(data[8:10]),
+		StyleID:        uint16(data[10]),
+		DivisionType:   data[11],
+		CharShapeCount: binary.LittleEndian.Uint16(data[12:14]),
+		RangeTagCount:  binary.LittleEndian.Uint16(data[14:16]),
+		LineAlignCount: binary.LittleEndian.Uint16(data[16:18]),
+		InstanceID:     binary.LittleEndian.Uint32(data[18:22]),
 	}
 
 	return para
@@ -434,12 +1168,5 @@ func (c *TableCell) GetCellText() string {
 		return ""
 	}
 
-	var texts []string
-	for _, p := range c.Paragraphs {
-		if text := strings.TrimSpace(p.Text); text != "" {
-			texts = append(texts, text)
-		}
-	}
-
-	return strings.Join(texts, "\n")
+	return joinParagraphText(c.Paragraphs)
 }
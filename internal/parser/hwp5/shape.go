@@ -0,0 +1,286 @@
+package hwp5
+
+import (
+	"encoding/binary"
+	"strings"
+)
+
+// 그리기 개체(SHAPE_COMPONENT) 종류 ID
+const (
+	ShapePicture   = "$pic" // 그림
+	ShapeRectangle = "$rec" // 사각형
+	ShapeEllipse   = "$ell" // 타원
+	ShapeContainer = "$con" // 묶음 개체
+	ShapeOLE       = "$ole" // OLE 개체 (차트, 스프레드시트 등)
+)
+
+// ObjectCommon은 개체 공통 속성 (GSO/표 CTRL_HEADER)
+// 참조: HWP 5.0 명세서 4.3.9.1 개체 공통 속성
+type ObjectCommon struct {
+	Attributes  uint32
+	YOffset     int32
+	XOffset     int32
+	Width       int32 // HWPUNIT (1/7200 inch)
+	Height      int32 // HWPUNIT
+	ZOrder      int32
+	InstanceID  uint32
+	Description string // 개체 설명문 (대체 텍스트)
+}
+
+// Crop은 그림 자르기 정보 (원본 이미지 좌표)
+type Crop struct {
+	Left   int32
+	Top    int32
+	Right  int32
+	Bottom int32
+}
+
+// IsEmpty returns true if no crop rectangle is set.
+func (c Crop) IsEmpty() bool {
+	return c.Left == 0 && c.Top == 0 && c.Right == 0 && c.Bottom == 0
+}
+
+// parseObjectCommon parses the common object properties following the control ID.
+func parseObjectCommon(data []byte) *ObjectCommon {
+	if len(data) < 40 {
+		return nil
+	}
+
+	obj := &ObjectCommon{
+		Attributes: binary.LittleEndian.Uint32(data[4:8]),
+		YOffset:    int32(binary.LittleEndian.Uint32(data[8:12])),
+		XOffset:    int32(binary.LittleEndian.Uint32(data[12:16])),
+		Width:      int32(binary.LittleEndian.Uint32(data[16:20])),
+		Height:     int32(binary.LittleEndian.Uint32(data[20:24])),
+		ZOrder:     int32(binary.LittleEndian.Uint32(data[24:28])),
+		InstanceID: binary.LittleEndian.Uint32(data[36:40]),
+	}
+
+	// [40:44] 쪽나눔 방지, 이후 설명문 (길이 + UTF-16LE)
+	offset := 44
+	if offset+2 <= len(data) {
+		descLen := int(binary.LittleEndian.Uint16(data[offset : offset+2]))
+		offset += 2
+		if descLen > 0 && offset+descLen*2 <= len(data) {
+			obj.Description = DecodeUTF16LE(data[offset : offset+descLen*2])
+		}
+	}
+
+	return obj
+}
+
+// parseShapeComponentID returns the shape type ID ("$pic", "$rec", ...) of a SHAPE_COMPONENT record.
+func parseShapeComponentID(data []byte) string {
+	return parseCtrlID(data)
+}
+
+// parsePictureRecord parses a SHAPE_PICTURE record.
+// 참조: HWP 5.0 명세서 4.3.9.4 그림 개체
+func parsePictureRecord(data []byte) *Image {
+	if len(data) < 73 {
+		return nil
+	}
+
+	img := &Image{
+		BorderColor: binary.LittleEndian.Uint32(data[0:4]),
+		Crop: Crop{
+			Left:   int32(binary.LittleEndian.Uint32(data[44:48])),
+			Top:    int32(binary.LittleEndian.Uint32(data[48:52])),
+			Right:  int32(binary.LittleEndian.Uint32(data[52:56])),
+			Bottom: int32(binary.LittleEndian.Uint32(data[56:60])),
+		},
+		// [60:68] 안쪽 여백, [68] 밝기, [69] 명암, [70] 효과
+		BinDataID: binary.LittleEndian.Uint16(data[71:73]),
+	}
+
+	return img
+}
+
+// parsePicture parses a GSO control starting at the CTRL_HEADER record.
+// Returns nil if the drawing object is not a picture.
+func (sp *SectionParser) parsePicture(startIdx int) *Image {
+	ctrlRec := sp.records[startIdx]
+	obj := parseObjectCommon(ctrlRec.Data)
+	end := sp.subtreeEnd(startIdx)
+
+	var img *Image
+	var caption string
+
+	i := startIdx + 1
+	for i < end {
+		rec := sp.records[i]
+
+		switch {
+		case rec.TagID == TagListHeader && rec.Level == ctrlRec.Level+1:
+			// 개체에 직접 딸린 LIST_HEADER는 캡션
+			paragraphs, nextIdx := sp.parseParagraphList(i)
+			caption = joinParagraphText(paragraphs)
+			i = nextIdx
+			continue
+
+		case rec.TagID == TagShapeComponent && rec.Level == ctrlRec.Level+1:
+			// 묶음 개체 안의 그림은 지원하지 않음
+			if parseShapeComponentID(rec.Data) != ShapePicture {
+				return nil
+			}
+
+		case rec.TagID == TagShapePicture && img == nil:
+			img = parsePictureRecord(rec.Data)
+		}
+
+		i++
+	}
+
+	if img == nil {
+		return nil
+	}
+
+	if obj != nil {
+		img.Width = obj.Width
+		img.Height = obj.Height
+		img.XOffset = obj.XOffset
+		img.YOffset = obj.YOffset
+		img.Description = obj.Description
+	}
+	img.Caption = caption
+
+	return img
+}
+
+// OLEObject는 문서에 삽입된 OLE 개체 (스프레드시트, 차트 등)
+type OLEObject struct {
+	BinDataID   uint16
+	Width       int32 // HWPUNIT
+	Height      int32 // HWPUNIT
+	Description string
+	Caption     string
+}
+
+// parseOLE parses a GSO control starting at the CTRL_HEADER record.
+// 참조: HWP 5.0 명세서 4.3.9.6 OLE 개체 - 속성(4), 크기(8), BinData ID(2), ...
+// 차트 개체의 CHART_DATA 레코드는 형식이 공개되지 않아 BinData의 차트 XML을 대신 사용한다.
+// Returns nil if the drawing object is not an OLE object.
+func (sp *SectionParser) parseOLE(startIdx int) *OLEObject {
+	ctrlRec := sp.records[startIdx]
+	obj := parseObjectCommon(ctrlRec.Data)
+	end := sp.subtreeEnd(startIdx)
+
+	var ole *OLEObject
+	var caption string
+
+	i := startIdx + 1
+	for i < end {
+		rec := sp.records[i]
+
+		switch {
+		case rec.TagID == TagListHeader && rec.Level == ctrlRec.Level+1:
+			paragraphs, nextIdx := sp.parseParagraphList(i)
+			caption = joinParagraphText(paragraphs)
+			i = nextIdx
+			continue
+
+		case rec.TagID == TagShapeComponent && rec.Level == ctrlRec.Level+1:
+			if parseShapeComponentID(rec.Data) != ShapeOLE {
+				return nil
+			}
+
+		case rec.TagID == TagShapeOLE && ole == nil && len(rec.Data) >= 14:
+			ole = &OLEObject{BinDataID: binary.LittleEndian.Uint16(rec.Data[12:14])}
+		}
+
+		i++
+	}
+
+	if ole == nil {
+		return nil
+	}
+
+	if obj != nil {
+		ole.Width = obj.Width
+		ole.Height = obj.Height
+		ole.Description = obj.Description
+	}
+	ole.Caption = caption
+
+	return ole
+}
+
+// TextBox는 글상자 (글자를 넣은 사각형, 타원 등 그리기 개체)
+type TextBox struct {
+	Paragraphs []*Paragraph
+}
+
+// parseTextBox parses the text of a GSO control starting at the CTRL_HEADER record.
+// 그리기 개체(SHAPE_COMPONENT) 아래의 LIST_HEADER가 글상자 내용이며, 묶음 개체는 모든 하위 개체의 글을 모은다.
+// Returns nil if the drawing object has no text.
+func (sp *SectionParser) parseTextBox(startIdx int) *TextBox {
+	ctrlRec := sp.records[startIdx]
+	end := sp.subtreeEnd(startIdx)
+
+	textBox := &TextBox{}
+	i := startIdx + 1
+	for i < end {
+		rec := sp.records[i]
+
+		// 개체에 직접 딸린 LIST_HEADER(캡션)는 제외
+		if rec.TagID == TagListHeader && rec.Level > ctrlRec.Level+1 {
+			paragraphs, nextIdx := sp.parseParagraphList(i)
+			textBox.Paragraphs = append(textBox.Paragraphs, paragraphs...)
+			i = max(nextIdx, i+1)
+			continue
+		}
+
+		i++
+	}
+
+	if strings.TrimSpace(joinParagraphText(textBox.Paragraphs)) == "" {
+		return nil
+	}
+	return textBox
+}
+
+// parseParagraphList parses the paragraphs that follow a LIST_HEADER record.
+// 리스트의 문단은 LIST_HEADER와 같은 레벨의 PARA_HEADER로 이어진다.
+func (sp *SectionParser) parseParagraphList(startIdx int) ([]*Paragraph, int) {
+	listLevel := sp.records[startIdx].Level
+	i := startIdx + 1
+
+	var paragraphs []*Paragraph
+	for i < len(sp.records) {
+		rec := sp.records[i]
+		if rec.Level < listLevel || (rec.Level == listLevel && rec.TagID != TagParaHeader) {
+			break
+		}
+
+		if rec.TagID == TagParaHeader && rec.Level == listLevel {
+			blocks, nextIdx := sp.parseParagraphBlocks(i)
+			for _, block := range blocks {
+				if block.Type == BlockParagraph {
+					paragraphs = append(paragraphs, block.Paragraph)
+				}
+			}
+			i = nextIdx
+			continue
+		}
+
+		i++
+	}
+
+	return paragraphs, i
+}
+
+// joinParagraphText joins the trimmed text of paragraphs with newlines.
+func joinParagraphText(paragraphs []*Paragraph) string {
+	var texts []string
+	for _, p := range paragraphs {
+		if text := strings.TrimSpace(p.Text); text != "" {
+			texts = append(texts, text)
+		}
+	}
+	return strings.Join(texts, "\n")
+}
+
+// hwpUnitToPixels converts HWPUNIT (1/7200 inch) to pixels at 96 DPI.
+func hwpUnitToPixels(v int32) int {
+	return int(int64(v) * 96 / 7200)
+}
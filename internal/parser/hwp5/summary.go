@@ -0,0 +1,170 @@
+package hwp5
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// HwpSummaryInformation 스트림 (OLE 속성 집합) 파싱
+// 참조: HWP 5.0 명세서 3.2.2 문서 요약, [MS-OLEPS] Property Set Stream
+
+// 문서 요약 속성 ID (PIDSI_*)
+const (
+	PropTitle        uint32 = 0x02 // 제목
+	PropSubject      uint32 = 0x03 // 주제
+	PropAuthor       uint32 = 0x04 // 지은이
+	PropKeywords     uint32 = 0x05 // 키워드
+	PropComments     uint32 = 0x06 // 설명
+	PropLastSavedBy  uint32 = 0x08 // 마지막 저장한 사람
+	PropRevision     uint32 = 0x09 // 수정 번호 (저장 프로그램)
+	PropLastPrinted  uint32 = 0x0B // 마지막 인쇄 시각
+	PropCreateTime   uint32 = 0x0C // 작성 시각
+	PropLastSaveTime uint32 = 0x0D // 마지막 저장 시각
+	PropPageCount    uint32 = 0x0E // 쪽 수
+	PropDate         uint32 = 0x14 // 날짜 (문자열)
+)
+
+// 속성 값 형식 (VT_*)
+const (
+	vtI2       uint32 = 0x0002
+	vtI4       uint32 = 0x0003
+	vtLPSTR    uint32 = 0x001E
+	vtLPWSTR   uint32 = 0x001F
+	vtFiletime uint32 = 0x0040
+)
+
+// SummaryInfo는 문서 요약 정보
+type SummaryInfo struct {
+	Title       string
+	Subject     string
+	Author      string
+	Keywords    string
+	Comments    string
+	LastSavedBy string
+	Created     time.Time
+	Modified    time.Time
+	PageCount   int
+}
+
+// ParseSummaryInfo parses the HwpSummaryInformation property set stream.
+func ParseSummaryInfo(data []byte) (*SummaryInfo, error) {
+	// 헤더: 바이트 순서(2), 버전(2), 시스템 ID(4), CLSID(16), 속성 집합 수(4)
+	// 이후 속성 집합마다 FMTID(16), 오프셋(4)
+	if len(data) < 48 {
+		return nil, fmt.Errorf("문서 요약 정보 크기가 너무 작습니다: %d", len(data))
+	}
+	if binary.LittleEndian.Uint16(data[0:2]) != 0xFFFE {
+		return nil, fmt.Errorf("문서 요약 정보의 바이트 순서 표시가 잘못되었습니다")
+	}
+
+	setOffset := int(binary.LittleEndian.Uint32(data[44:48]))
+	if setOffset+8 > len(data) {
+		return nil, fmt.Errorf("문서 요약 정보의 속성 집합 위치가 잘못되었습니다: %d", setOffset)
+	}
+	set := data[setOffset:]
+
+	// 속성 집합: 크기(4), 속성 수(4), (속성 ID, 오프셋) 목록
+	count := int(binary.LittleEndian.Uint32(set[4:8]))
+	info := &SummaryInfo{}
+	for i := 0; i < count; i++ {
+		entry := 8 + i*8
+		if entry+8 > len(set) {
+			break
+		}
+		id := binary.LittleEndian.Uint32(set[entry : entry+4])
+		offset := int(binary.LittleEndian.Uint32(set[entry+4 : entry+8]))
+		if offset+4 > len(set) {
+			continue
+		}
+		info.setProperty(id, set[offset:])
+	}
+
+	return info, nil
+}
+
+// setProperty stores a typed property value.
+func (s *SummaryInfo) setProperty(id uint32, value []byte) {
+	switch id {
+	case PropTitle:
+		s.Title = propertyString(value)
+	case PropSubject:
+		s.Subject = propertyString(value)
+	case PropAuthor:
+		s.Author = propertyString(value)
+	case PropKeywords:
+		s.Keywords = propertyString(value)
+	case PropComments:
+		s.Comments = propertyString(value)
+	case PropLastSavedBy:
+		s.LastSavedBy = propertyString(value)
+	case PropCreateTime:
+		s.Created = propertyTime(value)
+	case PropLastSaveTime:
+		s.Modified = propertyTime(value)
+	case PropPageCount:
+		s.PageCount = propertyInt(value)
+	}
+}
+
+// propertyString decodes a VT_LPWSTR (or ASCII VT_LPSTR) property value.
+func propertyString(value []byte) string {
+	if len(value) < 8 {
+		return ""
+	}
+	vt := binary.LittleEndian.Uint32(value[0:4])
+	n := int(binary.LittleEndian.Uint32(value[4:8]))
+
+	var s string
+	switch vt {
+	case vtLPWSTR:
+		// 길이는 NULL을 포함한 문자 수
+		if 8+n*2 > len(value) {
+			return ""
+		}
+		s = DecodeUTF16LE(value[8 : 8+n*2])
+	case vtLPSTR:
+		// 길이는 NULL을 포함한 바이트 수
+		if 8+n > len(value) {
+			return ""
+		}
+		s = string(value[8 : 8+n])
+	default:
+		return ""
+	}
+	return strings.TrimSpace(strings.TrimRight(s, "\x00"))
+}
+
+// propertyTime decodes a VT_FILETIME property value (100ns intervals since 1601-01-01 UTC).
+func propertyTime(value []byte) time.Time {
+	if len(value) < 12 || binary.LittleEndian.Uint32(value[0:4]) != vtFiletime {
+		return time.Time{}
+	}
+	ft := binary.LittleEndian.Uint64(value[4:12])
+	if ft == 0 {
+		return time.Time{}
+	}
+
+	// 1601-01-01과 1970-01-01 사이의 100ns 단위 차이
+	const epochDiff = 116444736000000000
+	if ft < epochDiff {
+		return time.Time{}
+	}
+	ticks := ft - epochDiff
+	return time.Unix(int64(ticks/10000000), int64(ticks%10000000)*100).UTC()
+}
+
+// propertyInt decodes a VT_I4 or VT_I2 property value.
+func propertyInt(value []byte) int {
+	if len(value) < 8 {
+		return 0
+	}
+	switch binary.LittleEndian.Uint32(value[0:4]) {
+	case vtI4:
+		return int(int32(binary.LittleEndian.Uint32(value[4:8])))
+	case vtI2:
+		return int(int16(binary.LittleEndian.Uint16(value[4:6])))
+	}
+	return 0
+}
@@ -3,6 +3,7 @@ package hwp5
 import (
 	"encoding/binary"
 	"strings"
+	"unicode"
 	"unicode/utf16"
 )
 
@@ -53,7 +54,13 @@ func (te *TextExtractor) ExtractText(data []byte) string {
 		case CharPara:
 			// 문단 나눔 - 이미 문단 단위로 처리하므로 무시
 		case CharTab:
+			// 탭은 인라인 컨트롤 - 14바이트 추가 정보 건너뛰기
+			if i+14 <= len(data) {
+				i += 14
+			}
 			sb.WriteString("\t")
+		case CharLineBreak:
+			sb.WriteString("\n")
 		case CharDrawingObj:
 			// 그리기 개체/표 - 14바이트 추가 정보 건너뛰기 (총 16바이트 - 이미 읽은 2바이트)
 			if i+14 <= len(data) {
@@ -151,30 +158,49 @@ type ControlInfo struct {
 }
 
 // ExtractTextWithControls extracts text and control information.
+// 확장/인라인 컨트롤은 PARA_TEXT 안에서 등장한 순서대로 기록되며,
+// 확장 컨트롤의 순서는 문단에 딸린 CTRL_HEADER 레코드의 순서와 같다.
 func (te *TextExtractor) ExtractTextWithControls(data []byte) (string, []ControlInfo) {
+	text, controls, _ := te.ExtractTextWithPositions(data)
+	return text, controls
+}
+
+// ExtractTextWithPositions extracts text and control information together with
+// the raw position of every extracted rune.
+// 위치는 PARA_TEXT 내 WCHAR 단위 오프셋으로, 컨트롤 문자는 8 WCHAR를 차지한다.
+// PARA_CHAR_SHAPE 등 문단 부속 레코드의 위치 값이 이 단위를 사용한다.
+func (te *TextExtractor) ExtractTextWithPositions(data []byte) (string, []ControlInfo, []int) {
 	if len(data) < 2 {
-		return "", nil
+		return "", nil, nil
 	}
 
 	var sb strings.Builder
 	var controls []ControlInfo
+	var positions []int
 	i := 0
 	textOffset := 0
 
 	for i+1 < len(data) {
 		char := binary.LittleEndian.Uint16(data[i : i+2])
+		rawPos := i / 2
 		i += 2
 
-		switch char {
-		case CharLine:
+		switch {
+		case char == CharLine || char == CharLineBreak:
 			sb.WriteString("\n")
+			positions = append(positions, rawPos)
 			textOffset++
-		case CharPara:
+		case char == CharPara:
 			// 무시
-		case CharTab:
+		case char == CharTab:
+			// 탭은 인라인 컨트롤 - 14바이트 추가 정보 (탭 너비 등)
+			if i+14 <= len(data) {
+				i += 14
+			}
 			sb.WriteString("\t")
+			positions = append(positions, rawPos)
 			textOffset++
-		case CharDrawingObj, CharInlineStart, CharFieldStart, CharBookmark, CharTitleMark, CharExtChar:
+		case isExtendedControl(char) || isInlineControl(char):
 			// 컨트롤 문자 - 14바이트 추가 정보 (총 16바이트)
 			if i+14 <= len(data) {
 				ctrlID := binary.LittleEndian.Uint32(data[i : i+4])
@@ -185,32 +211,67 @@ func (te *TextExtractor) ExtractTextWithControls(data []byte) (string, []Control
 				})
 				i += 14
 			}
-		case CharFieldEnd:
-			// 필드 끝
-		case CharHyphen:
+		case char == CharHyphen:
 			sb.WriteString("-")
+			positions = append(positions, rawPos)
 			textOffset++
-		case CharNBSP, CharFixedWidthNBSP:
+		case char == CharNBSP || char == CharFixedWidthNBSP:
 			sb.WriteString(" ")
+			positions = append(positions, rawPos)
 			textOffset++
-		default:
-			// 일반 문자 (UTF-16LE)
-			// Extended(1-3, 11-18, 21-23), Inline(4-9, 19-20)은 16바이트
-			if char >= 0x0001 && char <= 0x001F {
-				isExtended := (char >= 1 && char <= 3) || (char >= 11 && char <= 18) || (char >= 21 && char <= 23)
-				isInline := (char >= 4 && char <= 9) || (char >= 19 && char <= 20)
-
-				if isExtended || isInline {
-					if i+14 <= len(data) {
-						i += 14
-					}
-				}
-			} else if char >= 0x0020 {
-				sb.WriteRune(rune(char))
-				textOffset++
+		case utf16.IsSurrogate(rune(char)) && i+1 < len(data):
+			// 서로게이트 쌍 (BMP 밖의 문자)
+			low := binary.LittleEndian.Uint16(data[i : i+2])
+			if r := utf16.DecodeRune(rune(char), rune(low)); r != unicode.ReplacementChar {
+				i += 2
+				sb.WriteRune(r)
+			} else {
+				sb.WriteRune(unicode.ReplacementChar)
 			}
+			positions = append(positions, rawPos)
+			textOffset++
+		case char >= 0x0020:
+			// 일반 문자 (UTF-16LE)
+			sb.WriteRune(rune(char))
+			positions = append(positions, rawPos)
+			textOffset++
 		}
 	}
 
-	return sb.String(), controls
+	return sb.String(), controls, positions
+}
+
+// isExtendedControl reports whether char is an extended control character.
+// 확장 컨트롤(1-3, 11-18, 21-23)은 문단에 딸린 CTRL_HEADER 레코드를 하나씩 가진다.
+func isExtendedControl(char uint16) bool {
+	return (char >= 1 && char <= 3) || (char >= 11 && char <= 18) || (char >= 21 && char <= 23)
+}
+
+// isInlineControl reports whether char is an inline control character (4-9, 19-20).
+func isInlineControl(char uint16) bool {
+	return (char >= 4 && char <= 9) || (char >= 19 && char <= 20)
+}
+
+// IsExtended reports whether the control owns a CTRL_HEADER record.
+func (c ControlInfo) IsExtended() bool {
+	return isExtendedControl(c.Type)
+}
+
+// CtrlID returns the 4-character control ID (e.g. "tbl ", "gso ").
+func (c ControlInfo) CtrlID() string {
+	return ctrlIDString(c.ID)
+}
+
+// ctrlIDString converts a MAKE_4CHID value to its 4-character string form.
+func ctrlIDString(id uint32) string {
+	return string([]byte{byte(id >> 24), byte(id >> 16), byte(id >> 8), byte(id)})
+}
+
+// parseCtrlID reads the control ID at the start of a CTRL_HEADER record.
+// 레코드에는 리틀 엔디언으로 저장되므로 "tbl "이 " lbt"로 보인다.
+func parseCtrlID(data []byte) string {
+	if len(data) < 4 {
+		return ""
+	}
+	return ctrlIDString(binary.LittleEndian.Uint32(data[0:4]))
 }
@@ -0,0 +1,72 @@
+package hwpx
+
+import (
+	"encoding/xml"
+	"strconv"
+	"strings"
+
+	"github.com/roboco-io/hwp2md/internal/ir"
+	"github.com/roboco-io/hwp2md/internal/parser"
+)
+
+// objectState is a drawing object being parsed that may hold a caption (hp:caption).
+// Only table, picture, OLE and chart captions are kept with their object.
+type objectState struct {
+	table      *tableState         // hp:tbl
+	image      *ir.ImageBlock      // hp:pic, nil when images are not extracted
+	attachment *ir.AttachmentBlock // hp:ole, nil when attachments are not extracted
+	chart      *ir.TableBlock      // hp:ole or hp:chart holding chart data
+}
+
+// isCaptionedObject reports whether the element is an object that can have a caption.
+func isCaptionedObject(name string) bool {
+	switch name {
+	case "tbl", "pic", "equation", "ole", "chart", "video", "textart",
+		"rect", "ellipse", "arc", "polygon", "curve", "line", "connectLine", "container":
+		return true
+	}
+	return false
+}
+
+// autoNumState holds an automatic number (hp:autoNum) being parsed.
+// The decoration characters are in its hp:autoNumFormat child.
+type autoNumState struct {
+	number int
+	prefix string
+	suffix string
+}
+
+// text returns the text shown for the number (e.g. "3", "(3)").
+func (a *autoNumState) text() string {
+	return a.prefix + strconv.Itoa(a.number) + a.suffix
+}
+
+// numberKind converts a numType attribute value to a number kind.
+func numberKind(elem xml.StartElement) (parser.NumberKind, bool) {
+	switch strings.ToUpper(attrValue(elem, "numType")) {
+	case "PAGE":
+		return parser.NumberPage, true
+	case "FOOTNOTE":
+		return parser.NumberFootnote, true
+	case "ENDNOTE":
+		return parser.NumberEndnote, true
+	case "PICTURE":
+		return parser.NumberPicture, true
+	case "TABLE":
+		return parser.NumberTable, true
+	case "EQUATION":
+		return parser.NumberEquation, true
+	}
+	return 0, false
+}
+
+// captionText joins the trimmed text of caption paragraphs with newlines.
+func captionText(paragraphs []*ir.Paragraph) string {
+	var texts []string
+	for _, para := range paragraphs {
+		if text := strings.TrimSpace(para.Text); text != "" {
+			texts = append(texts, text)
+		}
+	}
+	return strings.Join(texts, "\n")
+}
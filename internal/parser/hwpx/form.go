@@ -0,0 +1,55 @@
+package hwpx
+
+import (
+	"encoding/xml"
+	"strings"
+
+	"github.com/roboco-io/hwp2md/internal/ir"
+)
+
+// clickHereState holds a click-here field being parsed (fieldBegin type="CLICK_HERE" ... fieldEnd).
+// The value of the field is the text between fieldBegin and fieldEnd.
+type clickHereState struct {
+	id    string
+	field *ir.FormField
+	text  strings.Builder
+}
+
+// formFieldType returns the field type of a form object element, or "" for
+// objects without a value (hp:btn, hp:scrollBar) and other elements.
+func formFieldType(localName string) ir.FormFieldType {
+	switch localName {
+	case "checkBtn":
+		return ir.FormFieldCheckBox
+	case "radioBtn":
+		return ir.FormFieldRadio
+	case "edit":
+		return ir.FormFieldText
+	case "comboBox":
+		return ir.FormFieldComboBox
+	case "listBox":
+		return ir.FormFieldListBox
+	}
+	return ""
+}
+
+// parseFormObject reads the attributes of a form object element.
+// Button values are CHECKED, UNCHECKED or INDETERMINATE; edit boxes keep their text in a
+// child hp:text element, which the caller reads.
+func parseFormObject(elem xml.StartElement, fieldType ir.FormFieldType) *ir.FormField {
+	field := &ir.FormField{Name: attrValue(elem, "name"), Type: fieldType}
+	switch fieldType {
+	case ir.FormFieldCheckBox, ir.FormFieldRadio:
+		field.Caption = attrValue(elem, "captionText")
+		if field.Caption == "" {
+			field.Caption = attrValue(elem, "caption")
+		}
+		field.Value = "false"
+		if attrValue(elem, "value") == "CHECKED" {
+			field.Value = "true"
+		}
+	case ir.FormFieldComboBox, ir.FormFieldListBox:
+		field.Value = attrValue(elem, "selectedValue")
+	}
+	return field
+}
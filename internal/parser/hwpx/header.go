@@ -0,0 +1,219 @@
+package hwpx
+
+import (
+	"encoding/xml"
+	"strings"
+
+	"github.com/roboco-io/hwp2md/internal/ir"
+	"github.com/roboco-io/hwp2md/internal/parser"
+)
+
+// Header represents the document header part (Contents/header.xml) holding shared definitions.
+type Header struct {
+	XMLName            xml.Name            `xml:"head"`
+	BeginNum           *BeginNum           `xml:"beginNum"`
+	BorderFills        []BorderFill        `xml:"refList>borderFills>borderFill"`
+	CharProperties     []CharPr            `xml:"refList>charProperties>charPr"`
+	Numberings         []Numbering         `xml:"refList>numberings>numbering"`
+	Bullets            []Bullet            `xml:"refList>bullets>bullet"`
+	ParaProperties     []ParaPr            `xml:"refList>paraProperties>paraPr"`
+	Styles             []Style             `xml:"refList>styles>style"`
+	TrackChanges       []TrackChange       `xml:"refList>trackChanges>trackChange"`
+	TrackChangeAuthors []TrackChangeAuthor `xml:"refList>trackChangeAuthors>trackChangeAuthor"`
+}
+
+// BorderFill is a border and fill definition referenced by table cells (borderFillIDRef).
+type BorderFill struct {
+	ID       string    `xml:"id,attr"`
+	WinBrush *WinBrush `xml:"fillBrush>winBrush"`
+}
+
+// WinBrush is a solid fill. FaceColor is "#RRGGBB" or "none".
+type WinBrush struct {
+	FaceColor string `xml:"faceColor,attr"`
+}
+
+// CharPr is a character shape referenced by runs (charPrIDRef).
+type CharPr struct {
+	ID         string    `xml:"id,attr"`
+	ShadeColor string    `xml:"shadeColor,attr"` // "#RRGGBB" or "none"
+	Bold       *struct{} `xml:"bold"`
+	Italic     *struct{} `xml:"italic"`
+	Underline  *struct {
+		Type string `xml:"type,attr"` // NONE, BOTTOM, CENTER, TOP
+	} `xml:"underline"`
+	Strikeout *struct {
+		Shape string `xml:"shape,attr"` // NONE, SOLID, DASH, ...
+	} `xml:"strikeout"`
+	Superscript *struct{} `xml:"supscript"`
+	Subscript   *struct{} `xml:"subscript"`
+}
+
+// ParaPr is a paragraph shape referenced by paragraphs (paraPrIDRef).
+type ParaPr struct {
+	ID    string `xml:"id,attr"`
+	Align struct {
+		Horizontal string `xml:"horizontal,attr"` // JUSTIFY, LEFT, RIGHT, CENTER, DISTRIBUTE, DISTRIBUTE_SPACE
+	} `xml:"align"`
+	Heading struct {
+		Type  string `xml:"type,attr"`  // NONE, OUTLINE, NUMBER, BULLET
+		IDRef int    `xml:"idRef,attr"` // numbering or bullet ID
+		Level int    `xml:"level,attr"` // 0-based level
+	} `xml:"heading"`
+}
+
+// Style is a paragraph or character style referenced by paragraphs (styleIDRef).
+type Style struct {
+	ID      string `xml:"id,attr"`
+	Type    string `xml:"type,attr"` // PARA, CHAR
+	Name    string `xml:"name,attr"`
+	EngName string `xml:"engName,attr"`
+}
+
+// BeginNum holds the start numbers of the document (pages, notes, pictures, tables and equations).
+type BeginNum struct {
+	Page     int `xml:"page,attr"`
+	Footnote int `xml:"footnote,attr"`
+	Endnote  int `xml:"endnote,attr"`
+	Picture  int `xml:"pic,attr"`
+	Table    int `xml:"tbl,attr"`
+	Equation int `xml:"equation,attr"`
+}
+
+// TrackChange describes one tracked change referenced by insertBegin/deleteBegin (TcId).
+type TrackChange struct {
+	ID       string `xml:"id,attr"`
+	Type     string `xml:"type,attr"` // Insert, Delete, ...
+	Date     string `xml:"date,attr"`
+	AuthorID string `xml:"authorID,attr"`
+}
+
+// TrackChangeAuthor is an author of tracked changes.
+type TrackChangeAuthor struct {
+	ID   string `xml:"id,attr"`
+	Name string `xml:"name,attr"`
+}
+
+// ParseHeader parses header XML data.
+func ParseHeader(data []byte) (*Header, error) {
+	var header Header
+	if err := xml.Unmarshal(data, &header); err != nil {
+		return nil, err
+	}
+	return &header, nil
+}
+
+// ChangeAuthors returns the author name of each tracked change, keyed by change ID.
+func (h *Header) ChangeAuthors() map[string]string {
+	names := make(map[string]string, len(h.TrackChangeAuthors))
+	for _, author := range h.TrackChangeAuthors {
+		names[author.ID] = author.Name
+	}
+
+	authors := make(map[string]string, len(h.TrackChanges))
+	for _, change := range h.TrackChanges {
+		if name := names[change.AuthorID]; name != "" {
+			authors[change.ID] = name
+		}
+	}
+	return authors
+}
+
+// Backgrounds returns the fill colour of each border fill with a visible solid fill, keyed by ID.
+// White fills are treated as no fill.
+func (h *Header) Backgrounds() map[string]string {
+	colors := make(map[string]string)
+	for _, bf := range h.BorderFills {
+		if bf.WinBrush == nil {
+			continue
+		}
+		color := strings.ToUpper(bf.WinBrush.FaceColor)
+		if strings.HasPrefix(color, "#") && color != "#FFFFFF" {
+			colors[bf.ID] = color
+		}
+	}
+	return colors
+}
+
+// TextStyles returns the IR text style of each character shape, keyed by ID.
+// Shades are kept as highlights unless they are white.
+func (h *Header) TextStyles() map[string]ir.TextStyle {
+	styles := make(map[string]ir.TextStyle, len(h.CharProperties))
+	for _, cp := range h.CharProperties {
+		style := ir.TextStyle{
+			Bold:          cp.Bold != nil,
+			Italic:        cp.Italic != nil,
+			Underline:     cp.Underline != nil && cp.Underline.Type != "" && cp.Underline.Type != "NONE",
+			Strikethrough: cp.Strikeout != nil && hasStrikeout(cp.Strikeout.Shape),
+			Superscript:   cp.Superscript != nil,
+			Subscript:     cp.Subscript != nil,
+		}
+		if color := strings.ToUpper(cp.ShadeColor); strings.HasPrefix(color, "#") && color != "#FFFFFF" {
+			style.Highlight = color
+		}
+		styles[cp.ID] = style
+	}
+	return styles
+}
+
+// Alignments returns the IR alignment (left, center, right) of each paragraph shape, keyed by ID.
+// Justified and distributed paragraphs are left out.
+func (h *Header) Alignments() map[string]string {
+	alignments := make(map[string]string)
+	for _, pp := range h.ParaProperties {
+		switch pp.Align.Horizontal {
+		case "LEFT":
+			alignments[pp.ID] = ir.AlignLeft
+		case "CENTER":
+			alignments[pp.ID] = ir.AlignCenter
+		case "RIGHT":
+			alignments[pp.ID] = ir.AlignRight
+		}
+	}
+	return alignments
+}
+
+// hasStrikeout reports whether a strikeout shape draws a line.
+// Hangul writes shape="3D" for character shapes without a strikeout (e.g. white label text),
+// so it is treated like NONE.
+func hasStrikeout(shape string) bool {
+	switch shape {
+	case "", "NONE", "3D":
+		return false
+	}
+	return true
+}
+
+// OutlineLevels returns the heading level (1-7) of each paragraph shape with an outline head, keyed by ID.
+func (h *Header) OutlineLevels() map[string]int {
+	levels := make(map[string]int)
+	for _, pp := range h.ParaProperties {
+		if pp.Heading.Type == "OUTLINE" {
+			levels[pp.ID] = pp.Heading.Level + 1
+		}
+	}
+	return levels
+}
+
+// StyleHeadingLevels returns the heading level implied by the name of each paragraph style, keyed by ID.
+// Styles that are not headings are left out.
+func (h *Header) StyleHeadingLevels() map[string]int {
+	levels := make(map[string]int)
+	for _, style := range h.Styles {
+		if style.Type != "" && style.Type != "PARA" {
+			continue
+		}
+		if level := parser.StyleHeadingLevel(style.Name, style.EngName); level > 0 {
+			levels[style.ID] = level
+		}
+	}
+	return levels
+}
+
+// AutoNumbers returns counters for caption numbers starting at the document start numbers.
+func (h *Header) AutoNumbers() *parser.AutoNumbers {
+	if h.BeginNum == nil {
+		return parser.NewAutoNumbers(1, 1, 1)
+	}
+	return parser.NewAutoNumbers(h.BeginNum.Picture, h.BeginNum.Table, h.BeginNum.Equation)
+}
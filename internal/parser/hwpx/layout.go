@@ -0,0 +1,91 @@
+package hwpx
+
+import (
+	"encoding/xml"
+	"strconv"
+
+	"github.com/roboco-io/hwp2md/internal/ir"
+	"github.com/roboco-io/hwp2md/internal/parser"
+)
+
+// lineSeg is the cached layout of one line (hp:lineseg) of a body paragraph.
+type lineSeg struct {
+	vertPos int32  // vertical position from the top of the column (HWPUNIT)
+	flags   uint32 // bit 0: first line of a page, bit 1: first line of a column
+}
+
+// parseLineSeg reads the position and flags of an hp:lineseg element.
+func parseLineSeg(elem xml.StartElement) lineSeg {
+	return lineSeg{
+		vertPos: int32(attrInt(elem, "vertpos")),
+		flags:   uint32(attrInt(elem, "flags")),
+	}
+}
+
+// isPageStart returns true if the line is flagged as the first line of a page.
+func (l lineSeg) isPageStart() bool {
+	return l.flags&0x01 != 0
+}
+
+// setPageSize applies an hp:pagePr element to a section.
+// The paper size is stored for the portrait orientation, so a landscape page has it swapped.
+// OWPML names the orientations WIDELY (portrait) and NARROWLY (landscape).
+func setPageSize(s *ir.Section, elem xml.StartElement) {
+	s.Width = parser.HWPUnitToMM(int32(attrInt(elem, "width")))
+	s.Height = parser.HWPUnitToMM(int32(attrInt(elem, "height")))
+	s.Orientation = ir.OrientationPortrait
+	if attrValue(elem, "landscape") == "NARROWLY" {
+		s.Orientation = ir.OrientationLandscape
+		if s.Width < s.Height {
+			s.Width, s.Height = s.Height, s.Width
+		}
+	}
+}
+
+// setPageMargins applies the hp:margin element of an hp:pagePr to a section.
+func setPageMargins(s *ir.Section, elem xml.StartElement) {
+	mm := func(name string) float64 {
+		return parser.HWPUnitToMM(int32(attrInt(elem, name)))
+	}
+	s.Margins = ir.Margins{
+		Top:    mm("top"),
+		Bottom: mm("bottom"),
+		Left:   mm("left"),
+		Right:  mm("right"),
+		Header: mm("header"),
+		Footer: mm("footer"),
+	}
+}
+
+// placeOnPage sets the page of the blocks added for a body paragraph from its line layout.
+// The lines are listed at the end of the paragraph, after its tables and text boxes were added,
+// so the blocks and form fields added since start are updated in place. A paragraph after
+// a page break always starts a new page.
+func (p *Parser) placeOnPage(doc *ir.Document, start int, pageBreak bool, lines []lineSeg) {
+	if len(lines) > 0 {
+		p.pages.Line(lines[0].vertPos, lines[0].isPageStart() || pageBreak)
+	}
+	page := p.pages.Page()
+	for i := start; i < len(doc.Content); i++ {
+		doc.Content[i].Page = page
+	}
+	for _, field := range doc.Fields {
+		if field.Block >= start {
+			field.Page = page
+		}
+	}
+	doc.SetPage(page)
+
+	for i := 1; i < len(lines); i++ {
+		p.pages.Line(lines[i].vertPos, lines[i].isPageStart())
+	}
+}
+
+// attrInt returns the named attribute as an integer, or 0 if missing or invalid.
+func attrInt(elem xml.StartElement, name string) int64 {
+	v, err := strconv.ParseInt(attrValue(elem, name), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return v
+}
@@ -0,0 +1,227 @@
+package hwpx
+
+import (
+	"strings"
+
+	"github.com/roboco-io/hwp2md/internal/ir"
+	"github.com/roboco-io/hwp2md/internal/parser"
+)
+
+// Numbering is a paragraph numbering definition referenced by numbered paragraph shapes.
+type Numbering struct {
+	ID        int        `xml:"id,attr"`
+	Start     int        `xml:"start,attr"`
+	ParaHeads []ParaHead `xml:"paraHead"`
+}
+
+// ParaHead is the number of one level of a numbering. Its text is the number format string,
+// where "^n" stands for the number of level n (e.g. "^1.", "(^2)").
+type ParaHead struct {
+	Level     int    `xml:"level,attr"` // 1-based level
+	Start     int    `xml:"start,attr"`
+	NumFormat string `xml:"numFormat,attr"` // DIGIT, HANGUL_SYLLABLE, CIRCLED_DIGIT, ROMAN_SMALL, ...
+	Text      string `xml:",chardata"`
+}
+
+// Bullet is a bullet definition referenced by bulleted paragraph shapes.
+type Bullet struct {
+	ID   int    `xml:"id,attr"`
+	Char string `xml:"char,attr"`
+}
+
+// listItemHead is the list head and the resolved marker of a body list paragraph.
+type listItemHead struct {
+	head   parser.ListHead
+	marker string
+}
+
+// numberFormat converts a numFormat attribute value to an IR number format.
+// Formats without an IR counterpart are shown as digits.
+func numberFormat(numFormat string) ir.NumberFormat {
+	switch strings.ToUpper(numFormat) {
+	case "CIRCLED_DIGIT":
+		return ir.NumberCircledDigit
+	case "ROMAN_CAPITAL":
+		return ir.NumberUpperRoman
+	case "ROMAN_SMALL":
+		return ir.NumberLowerRoman
+	case "LATIN_CAPITAL":
+		return ir.NumberUpperLatin
+	case "LATIN_SMALL":
+		return ir.NumberLowerLatin
+	case "HANGUL_SYLLABLE":
+		return ir.NumberHangulSyllable
+	case "CIRCLED_HANGUL_SYLLABLE":
+		return ir.NumberCircledHangulSyllable
+	case "HANGUL_JAMO":
+		return ir.NumberHangulJamo
+	case "CIRCLED_HANGUL_JAMO":
+		return ir.NumberCircledHangulJamo
+	case "IDEOGRAPH":
+		return ir.NumberIdeograph
+	}
+	return ir.NumberDigit
+}
+
+// level returns the paragraph head of a 0-based level, or nil if it is not defined.
+func (n *Numbering) level(level int) *ParaHead {
+	for i := range n.ParaHeads {
+		if n.ParaHeads[i].Level == level+1 {
+			return &n.ParaHeads[i]
+		}
+	}
+	return nil
+}
+
+// levelStart returns the start number of a 0-based level.
+func (n *Numbering) levelStart(level int) int {
+	if n == nil {
+		return 1
+	}
+	if head := n.level(level); head != nil && head.Start > 0 {
+		return head.Start
+	}
+	if level == 0 && n.Start > 0 {
+		return n.Start
+	}
+	return 1
+}
+
+// formats returns the number format of each level.
+func (n *Numbering) formats() [7]ir.NumberFormat {
+	var formats [7]ir.NumberFormat
+	for i := range formats {
+		formats[i] = ir.NumberDigit
+		if n == nil {
+			continue
+		}
+		if head := n.level(i); head != nil {
+			formats[i] = numberFormat(head.NumFormat)
+		}
+	}
+	return formats
+}
+
+// NumberingDefs returns the numbering definitions, keyed by ID.
+func (h *Header) NumberingDefs() map[int]*Numbering {
+	numberings := make(map[int]*Numbering, len(h.Numberings))
+	for i := range h.Numberings {
+		numberings[h.Numberings[i].ID] = &h.Numberings[i]
+	}
+	return numberings
+}
+
+// ListHeads returns the list head of each paragraph shape with a number or bullet head, keyed by ID.
+// The number is left for the parser; the marker is the number format string of the level
+// or the bullet character.
+func (h *Header) ListHeads() map[string]parser.ListHead {
+	numberings := h.NumberingDefs()
+	bullets := make(map[int]string, len(h.Bullets))
+	for _, bullet := range h.Bullets {
+		bullets[bullet.ID] = bullet.Char
+	}
+
+	heads := make(map[string]parser.ListHead)
+	for _, pp := range h.ParaProperties {
+		head := parser.ListHead{ID: pp.Heading.IDRef, Level: pp.Heading.Level}
+		switch pp.Heading.Type {
+		case "NUMBER":
+			numbering, ok := numberings[head.ID]
+			if !ok {
+				continue
+			}
+			head.Ordered = true
+			head.Format = numbering.formats()[min(max(head.Level, 0), 6)]
+			if level := numbering.level(head.Level); level != nil {
+				head.Marker = level.Text
+			}
+		case "BULLET":
+			char, ok := bullets[head.ID]
+			if !ok {
+				continue
+			}
+			head.Marker = char
+		default:
+			continue
+		}
+		heads[pp.ID] = head
+	}
+	return heads
+}
+
+// addListItem numbers a body list paragraph and keeps its list head until the section
+// is grouped into lists.
+func (p *Parser) addListItem(para *ir.Paragraph, head parser.ListHead) {
+	item := listItemHead{head: head, marker: head.Marker}
+	if head.Ordered {
+		numbering := p.numberings[head.ID]
+		if p.listCounters == nil {
+			p.listCounters = make(parser.ListCounters)
+		}
+		item.head.Number = p.listCounters.Next(head.ID, head.Level, numbering.levelStart(head.Level))
+		item.marker = parser.NumberMarker(head.Marker, p.listCounters.Numbers(head.ID), numbering.formats())
+	}
+	if p.listItems == nil {
+		p.listItems = make(map[*ir.Paragraph]listItemHead)
+	}
+	p.listItems[para] = item
+}
+
+// groupLists replaces the runs of list paragraphs added since start with nested list blocks.
+// A list takes the page, page break and anchors of its first item, and the anchors of its
+// other items. A page break ends the list so the break is kept.
+// Form fields located in the regrouped blocks are moved to the block that now holds them.
+func (p *Parser) groupLists(doc *ir.Document, start int) {
+	if len(p.listItems) == 0 {
+		return
+	}
+
+	var lists parser.ListBuilder
+	content := doc.Content[:start]
+	moved := make([]int, len(doc.Content)-start) // new index of each block from start
+	current := -1                                // index of the list being built in content
+	for i, block := range doc.Content[start:] {
+		item, ok := p.listItems[block.Paragraph]
+		if !ok || block.Type != ir.BlockTypeParagraph {
+			lists.Finish()
+			current = -1
+			content = append(content, block)
+			moved[i] = len(content) - 1
+			continue
+		}
+		delete(p.listItems, block.Paragraph)
+
+		if block.PageBreak {
+			lists.Finish()
+		}
+		root := lists.Root()
+		lists.Add(item.head, ir.ListItem{
+			Text:   strings.TrimSpace(block.Paragraph.Text),
+			Runs:   block.Paragraph.Runs,
+			Marker: item.marker,
+		})
+		if lists.Root() != root {
+			content = append(content, ir.Block{
+				Type:      ir.BlockTypeList,
+				List:      lists.Root(),
+				Anchors:   block.Anchors,
+				Page:      block.Page,
+				PageBreak: block.PageBreak,
+			})
+			current = len(content) - 1
+		} else {
+			content[current].Anchors = append(content[current].Anchors, block.Anchors...)
+		}
+		moved[i] = current
+	}
+
+	for _, field := range doc.Fields {
+		switch {
+		case field.Block >= start+len(moved):
+			field.Block -= start + len(moved) - len(content)
+		case field.Block >= start:
+			field.Block = moved[field.Block-start]
+		}
+	}
+	doc.Content = content
+}
@@ -3,6 +3,8 @@ package hwpx
 
 import (
 	"encoding/xml"
+	"fmt"
+	"strings"
 
 	"github.com/roboco-io/hwp2md/internal/ir"
 )
@@ -17,14 +19,22 @@ type Manifest struct {
 
 // ManifestMeta contains document metadata from the manifest.
 type ManifestMeta struct {
-	Title       string `xml:"title"`
-	Creator     string `xml:"creator"`
-	Subject     string `xml:"subject"`
-	Description string `xml:"description"`
-	Publisher   string `xml:"publisher"`
-	Date        string `xml:"date"`
-	Language    string `xml:"language"`
-	Keywords    string `xml:"keywords"`
+	Title       string     `xml:"title"`
+	Creator     string     `xml:"creator"`
+	Subject     string     `xml:"subject"`
+	Description string     `xml:"description"`
+	Publisher   string     `xml:"publisher"`
+	Date        string     `xml:"date"`
+	Language    string     `xml:"language"`
+	Keywords    string     `xml:"keywords"`
+	Meta        []MetaItem `xml:"meta"`
+}
+
+// MetaItem is a named metadata value (<opf:meta name="creator">...</opf:meta>).
+// Hangul stores the author, last editor and dates this way.
+type MetaItem struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:",chardata"`
 }
 
 // ManifestItem represents a single item in the manifest.
@@ -36,7 +46,8 @@ type ManifestItem struct {
 
 // SpineItem represents a spine reference for reading order.
 type SpineItem struct {
-	IDRef string `xml:"idref,attr"`
+	IDRef  string `xml:"idref,attr"`
+	Linear string `xml:"linear,attr"` // "no" for parts outside the reading order (e.g. header.xml)
 }
 
 // ParseManifest parses OPF-format manifest XML data.
@@ -49,49 +60,88 @@ func ParseManifest(data []byte) (*Manifest, error) {
 }
 
 // ToMetadata converts manifest metadata to IR metadata.
+// Metadata elements take precedence over named meta values.
 func (m *Manifest) ToMetadata() ir.Metadata {
+	meta := m.Metadata
+	creator := firstNonEmpty(meta.Creator, meta.value("creator"))
 	return ir.Metadata{
-		Title:       m.Metadata.Title,
-		Author:      m.Metadata.Creator,
-		Subject:     m.Metadata.Subject,
-		Description: m.Metadata.Description,
-		Keywords:    m.Metadata.Keywords,
-		Creator:     m.Metadata.Creator,
-		Created:     m.Metadata.Date,
+		Title:          meta.Title,
+		Author:         creator,
+		Subject:        firstNonEmpty(meta.Subject, meta.value("subject")),
+		Description:    firstNonEmpty(meta.Description, meta.value("description")),
+		Keywords:       firstNonEmpty(meta.Keywords, meta.value("keyword")),
+		Creator:        creator,
+		LastModifiedBy: meta.value("lastsaveby"),
+		Created:        firstNonEmpty(meta.Date, meta.value("CreatedDate")),
+		Modified:       meta.value("ModifiedDate"),
 	}
 }
 
-// GetSectionPaths returns ordered section file paths based on spine.
+// value returns the trimmed value of the named meta item, or "" if missing.
+func (m *ManifestMeta) value(name string) string {
+	for _, item := range m.Meta {
+		if item.Name == name {
+			return strings.TrimSpace(item.Value)
+		}
+	}
+	return ""
+}
+
+// firstNonEmpty returns the first non-empty string.
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// GetSectionPaths returns the section file paths in reading order.
+// Sections follow the spine; without sections in the spine they follow their part numbers.
 func (m *Manifest) GetSectionPaths() []string {
-	// Build id -> href map
-	itemMap := make(map[string]string)
+	// Build id -> item map
+	itemMap := make(map[string]ManifestItem)
 	for _, item := range m.Items {
-		itemMap[item.ID] = item.Href
+		itemMap[item.ID] = item
 	}
 
 	// Get sections in spine order
 	var paths []string
 	for _, ref := range m.Spine {
-		if href, ok := itemMap[ref.IDRef]; ok {
-			paths = append(paths, href)
+		if item, ok := itemMap[ref.IDRef]; ok && isSection(item) {
+			paths = append(paths, item.Href)
 		}
 	}
 
-	// If spine is empty, fall back to manifest order
+	// If the spine has no sections, fall back to the section part numbers
 	if len(paths) == 0 {
 		for _, item := range m.Items {
 			if isSection(item) {
 				paths = append(paths, item.Href)
 			}
 		}
+		sortParts(paths)
 	}
 
 	return paths
 }
 
+// validateSpine checks that every spine reference names a manifest item.
+func (m *Manifest) validateSpine() error {
+	ids := make(map[string]bool, len(m.Items))
+	for _, item := range m.Items {
+		ids[item.ID] = true
+	}
+	for _, ref := range m.Spine {
+		if !ids[ref.IDRef] {
+			return fmt.Errorf("spine refers to unknown item %q", ref.IDRef)
+		}
+	}
+	return nil
+}
+
 // isSection checks if a manifest item is a section file.
 func isSection(item ManifestItem) bool {
-	return item.MediaType == "application/xml" ||
-		item.MediaType == "text/xml" ||
-		(len(item.ID) > 0 && (item.ID[0] == 's' || item.ID[0] == 'S'))
+	return isSectionPath(item.Href)
 }
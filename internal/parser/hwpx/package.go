@@ -0,0 +1,334 @@
+package hwpx
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Package holds the structure of an HWPX archive: its parts in reading order
+// and the version and settings saved with the document.
+type Package struct {
+	Manifest    *Manifest         // nil for archives without a package document
+	Sections    []string          // section parts in reading order
+	MasterPages []string          // master page parts
+	Header      string            // header part with the document styles, "" if missing
+	BinData     map[string]string // manifest item id -> archive path
+	Version     *Version          // nil if version.xml is missing or malformed
+	Settings    *Settings         // nil if settings.xml is missing or malformed
+	Warnings    []error           // malformed optional parts that were left out
+}
+
+// Container is the OCF container (META-INF/container.xml) listing the package root files.
+type Container struct {
+	XMLName   xml.Name   `xml:"container"`
+	RootFiles []RootFile `xml:"rootfiles>rootfile"`
+}
+
+// RootFile is a root file of the container, such as the package document (content.hpf).
+type RootFile struct {
+	FullPath  string `xml:"full-path,attr"`
+	MediaType string `xml:"media-type,attr"`
+}
+
+// Version describes the application that wrote the document (version.xml).
+type Version struct {
+	XMLName           xml.Name `xml:"HCFVersion"`
+	TargetApplication string   `xml:"tagetApplication,attr"` // spelled this way by Hangul
+	Major             int      `xml:"major,attr"`
+	Minor             int      `xml:"minor,attr"`
+	Micro             int      `xml:"micro,attr"`
+	BuildNumber       int      `xml:"buildNumber,attr"`
+	OS                int      `xml:"os,attr"`
+	XMLVersion        string   `xml:"xmlVersion,attr"`
+	Application       string   `xml:"application,attr"`
+	AppVersion        string   `xml:"appVersion,attr"`
+}
+
+// Settings holds the application settings saved with the document (settings.xml).
+type Settings struct {
+	XMLName       xml.Name       `xml:"HWPApplicationSetting"`
+	CaretPosition *CaretPosition `xml:"CaretPosition"`
+}
+
+// CaretPosition is where the caret was when the document was saved.
+type CaretPosition struct {
+	ListIDRef int `xml:"listIDRef,attr"` // list (0 = body)
+	ParaIDRef int `xml:"paraIDRef,attr"` // paragraph in the list
+	Pos       int `xml:"pos,attr"`       // character position in the paragraph
+}
+
+const (
+	containerPath    = "META-INF/container.xml"
+	versionPath      = "version.xml"
+	settingsPath     = "settings.xml"
+	headerPath       = "Contents/header.xml"
+	packageMediaType = "application/hwpml-package+xml"
+)
+
+// defaultPackagePaths are tried when the archive has no container.xml.
+var defaultPackagePaths = []string{"Contents/content.hpf", "content.hpf"}
+
+// ParseContainer parses container.xml data.
+func ParseContainer(data []byte) (*Container, error) {
+	var container Container
+	if err := xml.Unmarshal(data, &container); err != nil {
+		return nil, err
+	}
+	return &container, nil
+}
+
+// PackagePath returns the path of the package document, or "" if none is listed.
+func (c *Container) PackagePath() string {
+	for _, rf := range c.RootFiles {
+		if rf.MediaType == packageMediaType {
+			return rf.FullPath
+		}
+	}
+	for _, rf := range c.RootFiles {
+		if strings.EqualFold(path.Ext(rf.FullPath), ".hpf") {
+			return rf.FullPath
+		}
+	}
+	return ""
+}
+
+// ParseVersion parses version.xml data.
+func ParseVersion(data []byte) (*Version, error) {
+	var version Version
+	if err := xml.Unmarshal(data, &version); err != nil {
+		return nil, err
+	}
+	return &version, nil
+}
+
+// String returns the file format version (e.g. "5.1.0.1").
+func (v *Version) String() string {
+	return fmt.Sprintf("%d.%d.%d.%d", v.Major, v.Minor, v.Micro, v.BuildNumber)
+}
+
+// ParseSettings parses settings.xml data.
+func ParseSettings(data []byte) (*Settings, error) {
+	var settings Settings
+	if err := xml.Unmarshal(data, &settings); err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+// LoadPackage reads the package structure of an HWPX archive.
+// The package document is found through META-INF/container.xml, falling back to the usual
+// content.hpf locations; sections are read in spine order. Archives without a package
+// document are scanned for section parts. Malformed package parts are reported as errors;
+// a missing version.xml or settings.xml leaves Version or Settings nil, and a malformed one
+// is reported in Warnings.
+func LoadPackage(r *zip.Reader) (*Package, error) {
+	pkg := &Package{BinData: make(map[string]string)}
+
+	packagePath, err := findPackagePath(r)
+	if err != nil {
+		return nil, err
+	}
+	if packagePath == "" {
+		pkg.scanParts(r)
+	} else if err := pkg.loadManifest(r, packagePath); err != nil {
+		return nil, err
+	}
+	if pkg.Header == "" && findZipFile(r, headerPath) != nil {
+		pkg.Header = headerPath
+	}
+
+	// version.xml and settings.xml are optional; unreadable ones are left out with a warning
+	if data, ok, err := readZipFile(r, versionPath); err != nil {
+		pkg.Warnings = append(pkg.Warnings, fmt.Errorf("failed to read %s: %w", versionPath, err))
+	} else if ok {
+		if pkg.Version, err = ParseVersion(data); err != nil {
+			pkg.Warnings = append(pkg.Warnings, fmt.Errorf("failed to parse %s: %w", versionPath, err))
+		}
+	}
+	if data, ok, err := readZipFile(r, settingsPath); err != nil {
+		pkg.Warnings = append(pkg.Warnings, fmt.Errorf("failed to read %s: %w", settingsPath, err))
+	} else if ok {
+		if pkg.Settings, err = ParseSettings(data); err != nil {
+			pkg.Warnings = append(pkg.Warnings, fmt.Errorf("failed to parse %s: %w", settingsPath, err))
+		}
+	}
+
+	return pkg, nil
+}
+
+// findPackagePath returns the path of the package document, or "" if the archive has none.
+func findPackagePath(r *zip.Reader) (string, error) {
+	data, ok, err := readZipFile(r, containerPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", containerPath, err)
+	}
+	if ok {
+		container, err := ParseContainer(data)
+		if err != nil {
+			return "", fmt.Errorf("failed to parse %s: %w", containerPath, err)
+		}
+		packagePath := container.PackagePath()
+		if packagePath == "" {
+			return "", fmt.Errorf("%s lists no package document", containerPath)
+		}
+		if findZipFile(r, packagePath) == nil {
+			return "", fmt.Errorf("package document not found: %s", packagePath)
+		}
+		return packagePath, nil
+	}
+
+	for _, name := range defaultPackagePaths {
+		if findZipFile(r, name) != nil {
+			return name, nil
+		}
+	}
+	return "", nil
+}
+
+// loadManifest reads the package document and resolves its parts.
+func (pkg *Package) loadManifest(r *zip.Reader, packagePath string) error {
+	data, _, err := readZipFile(r, packagePath)
+	if err != nil {
+		return fmt.Errorf("failed to read manifest: %w", err)
+	}
+	manifest, err := ParseManifest(data)
+	if err != nil {
+		return fmt.Errorf("failed to parse manifest: %w", err)
+	}
+	if err := manifest.validateSpine(); err != nil {
+		return fmt.Errorf("invalid manifest: %w", err)
+	}
+	pkg.Manifest = manifest
+
+	// Hangul writes hrefs relative to the archive root, while OPF resolves them
+	// against the package document; accept both
+	base := path.Dir(packagePath)
+	resolve := func(href string) string {
+		href = strings.TrimPrefix(href, "/")
+		if findZipFile(r, href) == nil {
+			if joined := path.Join(base, href); findZipFile(r, joined) != nil {
+				return joined
+			}
+		}
+		return href
+	}
+
+	for _, href := range manifest.GetSectionPaths() {
+		section := resolve(href)
+		if findZipFile(r, section) == nil {
+			return fmt.Errorf("section not found in package: %s", href)
+		}
+		pkg.Sections = append(pkg.Sections, section)
+	}
+	if len(pkg.Sections) == 0 {
+		return fmt.Errorf("manifest lists no sections")
+	}
+
+	for _, item := range manifest.Items {
+		href := resolve(item.Href)
+		if isMasterPage(href) {
+			pkg.MasterPages = append(pkg.MasterPages, href)
+		}
+		if pkg.Header == "" && isHeader(item) && findZipFile(r, href) != nil {
+			pkg.Header = href
+		}
+		if strings.HasPrefix(href, "BinData/") {
+			pkg.BinData[item.ID] = href
+		}
+	}
+	sortParts(pkg.MasterPages)
+	return nil
+}
+
+// scanParts finds the section, master page and binary parts of an archive without a package document.
+func (pkg *Package) scanParts(r *zip.Reader) {
+	for _, f := range r.File {
+		name := f.Name
+		if isSectionPath(name) {
+			pkg.Sections = append(pkg.Sections, name)
+		}
+		if isMasterPage(name) {
+			pkg.MasterPages = append(pkg.MasterPages, name)
+		}
+		if strings.HasPrefix(name, "BinData/") {
+			// Use filename without extension as ID
+			base := filepath.Base(name)
+			id := strings.TrimSuffix(base, filepath.Ext(base))
+			pkg.BinData[id] = name
+		}
+	}
+	sortParts(pkg.Sections)
+	sortParts(pkg.MasterPages)
+}
+
+// isSectionPath reports whether a part is a section (e.g. Contents/section0.xml).
+func isSectionPath(name string) bool {
+	base := strings.ToLower(path.Base(name))
+	return strings.HasPrefix(base, "section") && strings.HasSuffix(base, ".xml")
+}
+
+// isHeader reports whether a manifest item is the header part (e.g. Contents/header.xml).
+func isHeader(item ManifestItem) bool {
+	return strings.EqualFold(item.ID, "header") || strings.EqualFold(path.Base(item.Href), "header.xml")
+}
+
+// isMasterPage reports whether a part is a master page (e.g. Contents/masterpage0.xml).
+func isMasterPage(name string) bool {
+	name = strings.ToLower(name)
+	return strings.Contains(name, "masterpage") && strings.HasSuffix(name, ".xml")
+}
+
+// sortParts sorts part names naturally, so section2.xml comes before section10.xml.
+func sortParts(names []string) {
+	sort.SliceStable(names, func(i, j int) bool {
+		pi, ni := splitPartNumber(names[i])
+		pj, nj := splitPartNumber(names[j])
+		if pi != pj {
+			return pi < pj
+		}
+		return ni < nj
+	})
+}
+
+// splitPartNumber splits a part name into the name before its trailing number and the number.
+func splitPartNumber(name string) (string, int) {
+	base := strings.TrimSuffix(name, path.Ext(name))
+	i := len(base)
+	for i > 0 && base[i-1] >= '0' && base[i-1] <= '9' {
+		i--
+	}
+	n, _ := strconv.Atoi(base[i:])
+	return base[:i], n
+}
+
+// findZipFile returns the archive entry with the given name (case-insensitive), or nil.
+func findZipFile(r *zip.Reader, name string) *zip.File {
+	for _, f := range r.File {
+		if strings.EqualFold(f.Name, name) {
+			return f
+		}
+	}
+	return nil
+}
+
+// readZipFile reads an archive entry. ok is false if the entry does not exist.
+func readZipFile(r *zip.Reader, name string) (data []byte, ok bool, err error) {
+	f := findZipFile(r, name)
+	if f == nil {
+		return nil, false, nil
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return nil, true, err
+	}
+	defer rc.Close()
+	data, err = io.ReadAll(rc)
+	return data, true, err
+}
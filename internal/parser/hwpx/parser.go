@@ -8,10 +8,12 @@ import (
 	"io"
 	"os"
 	"path/filepath"
-	"sort"
 	"strings"
 
+	"github.com/roboco-io/hwp2md/internal/chart"
+	"github.com/roboco-io/hwp2md/internal/equation"
 	"github.com/roboco-io/hwp2md/internal/ir"
+	"github.com/roboco-io/hwp2md/internal/ole"
 	"github.com/roboco-io/hwp2md/internal/parser"
 )
 
@@ -22,9 +24,27 @@ type Parser struct {
 	options parser.Options
 
 	// Parsed data
-	manifest *Manifest
-	sections []string
-	binData  map[string]string // id -> path mapping
+	manifest      *Manifest
+	version       *Version                   // version.xml, nil if missing
+	settings      *Settings                  // settings.xml, nil if missing
+	warnings      []error                    // malformed optional parts that were left out
+	sections      []string                   // section parts in reading order
+	masterPages   []string                   // master page parts (Contents/masterpage*.xml)
+	binData       map[string]string          // id -> path mapping
+	changeAuthors map[string]string          // tracked change id -> author name (header.xml)
+	backgrounds   map[string]string          // border fill id -> fill colour (header.xml)
+	alignments    map[string]string          // paragraph shape id -> alignment (header.xml)
+	charStyles    map[string]ir.TextStyle    // character shape id -> text style (header.xml)
+	outlines      map[string]int             // paragraph shape id -> outline heading level (header.xml)
+	styleHeadings map[string]int             // style id -> heading level implied by its name (header.xml)
+	listHeads     map[string]parser.ListHead // paragraph shape id -> number or bullet head (header.xml)
+	numberings    map[int]*Numbering         // numbering id -> numbering definition (header.xml)
+
+	pages   parser.PageCounter  // page estimated from the line layout
+	numbers *parser.AutoNumbers // caption numbers, continued across sections
+
+	listCounters parser.ListCounters            // paragraph numbers, continued across sections
+	listItems    map[*ir.Paragraph]listItemHead // body list paragraphs not yet grouped into lists
 }
 
 // New creates a new HWPX parser for the given file path.
@@ -34,19 +54,26 @@ func New(path string, opts parser.Options) (*Parser, error) {
 		return nil, fmt.Errorf("failed to open HWPX file: %w", err)
 	}
 
-	p := &Parser{
-		path:    path,
-		reader:  r,
-		options: opts,
-		binData: make(map[string]string),
-	}
-
-	// Parse manifest
-	if err := p.parseManifest(); err != nil {
+	pkg, err := LoadPackage(&r.Reader)
+	if err != nil {
 		r.Close()
 		return nil, err
 	}
 
+	p := &Parser{
+		path:        path,
+		reader:      r,
+		options:     opts,
+		manifest:    pkg.Manifest,
+		version:     pkg.Version,
+		settings:    pkg.Settings,
+		warnings:    pkg.Warnings,
+		sections:    pkg.Sections,
+		masterPages: pkg.MasterPages,
+		binData:     pkg.BinData,
+	}
+	p.parseHeader(pkg.Header)
+
 	return p, nil
 }
 
@@ -58,6 +85,10 @@ func (p *Parser) Parse() (*ir.Document, error) {
 	if p.manifest != nil {
 		doc.Metadata = p.manifest.ToMetadata()
 	}
+	// As for HWP 5.x files, the creator is the file format version
+	if p.version != nil {
+		doc.Metadata.Creator = fmt.Sprintf("HWP %s", p.version.String())
+	}
 
 	// Parse each section in order
 	for _, sectionPath := range p.sections {
@@ -66,6 +97,14 @@ func (p *Parser) Parse() (*ir.Document, error) {
 		}
 	}
 
+	// Master pages share the section paragraph format (<masterPage><subList>...)
+	for _, masterPagePath := range p.masterPages {
+		if err := p.parseSection(doc, masterPagePath); err != nil {
+			return nil, fmt.Errorf("failed to parse master page %s: %w", masterPagePath, err)
+		}
+	}
+	doc.FlushAnchors()
+
 	return doc, nil
 }
 
@@ -77,90 +116,53 @@ func (p *Parser) Close() error {
 	return nil
 }
 
-// parseManifest reads and parses the content.hpf manifest file.
-func (p *Parser) parseManifest() error {
-	// Try different manifest locations
-	manifestPaths := []string{
-		"Contents/content.hpf",
-		"content.hpf",
-	}
+// Version returns the application version saved with the document, or nil if missing.
+func (p *Parser) Version() *Version {
+	return p.version
+}
 
-	var manifestFile *zip.File
-	for _, path := range manifestPaths {
-		for _, f := range p.reader.File {
-			if strings.EqualFold(f.Name, path) {
-				manifestFile = f
-				break
-			}
-		}
-		if manifestFile != nil {
-			break
-		}
-	}
+// Settings returns the application settings saved with the document, or nil if missing.
+func (p *Parser) Settings() *Settings {
+	return p.settings
+}
 
-	if manifestFile == nil {
-		// No manifest found, try to find sections directly
-		return p.findSectionsWithoutManifest()
-	}
+// Warnings returns the malformed optional parts (version.xml, settings.xml) that were left out.
+func (p *Parser) Warnings() []error {
+	return p.warnings
+}
 
-	rc, err := manifestFile.Open()
-	if err != nil {
-		return fmt.Errorf("failed to open manifest: %w", err)
+// GetVersion returns the file format version string (e.g. "5.1.0.1"), or "" if unknown.
+func (p *Parser) GetVersion() string {
+	if p.version != nil {
+		return p.version.String()
 	}
-	defer rc.Close()
+	return ""
+}
 
-	data, err := io.ReadAll(rc)
-	if err != nil {
-		return fmt.Errorf("failed to read manifest: %w", err)
+// parseHeader reads what the sections refer to from the header part listed in the manifest:
+// tracked change authors, start numbers, cell fills, paragraph alignments, character styles,
+// outline and style heading levels, list heads and numberings. Errors are ignored; without a
+// header the text is kept but loses that formatting (no headings from styles, plain lists and
+// cells) and numbers start at 1.
+func (p *Parser) parseHeader(headerPath string) {
+	if headerPath == "" {
+		return
 	}
-
-	manifest, err := ParseManifest(data)
+	data, _, err := readZipFile(&p.reader.Reader, headerPath)
 	if err != nil {
-		return fmt.Errorf("failed to parse manifest: %w", err)
-	}
-
-	p.manifest = manifest
-
-	// Extract section paths and bindata mappings
-	for _, item := range manifest.Items {
-		href := item.Href
-		// Normalize path
-		if !strings.HasPrefix(href, "/") && !strings.HasPrefix(href, "Contents/") {
-			if strings.HasSuffix(item.MediaType, "xml") && strings.Contains(item.ID, "section") {
-				href = "Contents/" + href
-			}
-		}
-
-		if strings.Contains(strings.ToLower(item.ID), "section") {
-			p.sections = append(p.sections, href)
-		}
-		if strings.HasPrefix(item.Href, "BinData/") {
-			p.binData[item.ID] = item.Href
-		}
+		return
 	}
-
-	// Sort sections by name
-	sort.Strings(p.sections)
-
-	return nil
-}
-
-// findSectionsWithoutManifest finds section files when manifest is missing.
-func (p *Parser) findSectionsWithoutManifest() error {
-	for _, f := range p.reader.File {
-		name := f.Name
-		if strings.Contains(name, "section") && strings.HasSuffix(name, ".xml") {
-			p.sections = append(p.sections, name)
-		}
-		if strings.HasPrefix(name, "BinData/") {
-			// Use filename without extension as ID
-			base := filepath.Base(name)
-			id := strings.TrimSuffix(base, filepath.Ext(base))
-			p.binData[id] = name
-		}
+	if header, err := ParseHeader(data); err == nil {
+		p.changeAuthors = header.ChangeAuthors()
+		p.numbers = header.AutoNumbers()
+		p.backgrounds = header.Backgrounds()
+		p.alignments = header.Alignments()
+		p.charStyles = header.TextStyles()
+		p.outlines = header.OutlineLevels()
+		p.styleHeadings = header.StyleHeadingLevels()
+		p.listHeads = header.ListHeads()
+		p.numberings = header.NumberingDefs()
 	}
-	sort.Strings(p.sections)
-	return nil
 }
 
 // parseSection parses a single section XML file.
@@ -189,14 +191,138 @@ func (p *Parser) parseSection(doc *ir.Document, sectionPath string) error {
 
 // tableState holds the state for a table being parsed.
 type tableState struct {
-	rows       [][]cellContext
-	currentRow []cellContext
-	cell       *cellContext
+	rows         [][]cellContext
+	currentRow   []cellContext
+	cell         *cellContext
+	subListDepth int    // number of enclosing sub-lists (footnote, header, ...) when the table started
+	caption      string // text of hp:caption
+}
+
+// subListState holds the paragraphs of a footnote, endnote, header, footer, master page
+// or text box being parsed.
+type subListState struct {
+	kind       string // element name (footNote, endNote, header, footer, masterPage, drawText)
+	scope      ir.PageScope
+	paragraphs []*ir.Paragraph
+}
+
+// pageScope converts an applyPageType/type attribute value to a page scope.
+func pageScope(elem xml.StartElement) ir.PageScope {
+	for _, attr := range elem.Attr {
+		if attr.Name.Local != "applyPageType" && attr.Name.Local != "type" {
+			continue
+		}
+		switch strings.ToUpper(attr.Value) {
+		case "EVEN":
+			return ir.PageScopeEven
+		case "ODD":
+			return ir.PageScopeOdd
+		}
+	}
+	return ir.PageScopeBoth
+}
+
+// hyperlinkState holds a hyperlink or cross-reference field being parsed (fieldBegin ... fieldEnd).
+type hyperlinkState struct {
+	id       string
+	command  string
+	path     string
+	url      string
+	crossRef bool // cross-reference to a bookmark (the URL is an #anchor)
+	inCell   bool // link text is written to a table cell as Markdown
+}
+
+// memoState holds a memo field being parsed (fieldBegin type="MEMO" ... fieldEnd).
+// The memo body is the subList inside fieldBegin; the commented range follows it up to fieldEnd.
+type memoState struct {
+	id     string
+	author string
+	date   string
+	ref    ir.Run // comment reference run, set once the memo body is read
+	inCell bool   // range is written to a table cell as CriticMarkup
+}
+
+// appendParagraphText appends text to a paragraph.
+// Runs are only kept once a paragraph has styled content (a link, footnote reference, change or comment).
+func appendParagraphText(para *ir.Paragraph, text string, style ir.TextStyle) {
+	if style == (ir.TextStyle{}) && len(para.Runs) == 0 {
+		para.Text += text
+		return
+	}
+
+	appendParagraphRun(para, ir.Run{Text: text, Style: style})
+}
+
+// appendParagraphRun appends a styled run (e.g. a footnote reference) to a paragraph.
+// The text collected so far becomes the first plain run.
+// Inline equations are written to the paragraph text as $...$.
+func appendParagraphRun(para *ir.Paragraph, run ir.Run) {
+	if len(para.Runs) == 0 && para.Text != "" {
+		para.Runs = append(para.Runs, ir.Run{Text: para.Text})
+	}
+	if run.Style.Math {
+		para.Text += "$" + run.Text + "$"
+	} else {
+		para.Text += run.Text
+	}
+
+	// Merge with the previous run of the same style (never merge footnote/comment references, equations or pictures)
+	if n := len(para.Runs); n > 0 && run.Style.FootnoteRef == "" && run.Style.CommentRef == "" && !run.Style.Math &&
+		run.Image == nil && para.Runs[n-1].Image == nil && para.Runs[n-1].Style == run.Style {
+		para.Runs[n-1].Text += run.Text
+		return
+	}
+	para.Runs = append(para.Runs, run)
+}
+
+// imagesOnly returns the pictures of a paragraph that holds nothing but pictures, or nil.
+func imagesOnly(para *ir.Paragraph) []*ir.ImageBlock {
+	if strings.TrimSpace(para.Text) != "" {
+		return nil
+	}
+	var images []*ir.ImageBlock
+	for _, run := range para.Runs {
+		if run.Image != nil {
+			images = append(images, run.Image)
+		} else if run.Style.FootnoteRef != "" || run.Style.CommentRef != "" {
+			return nil
+		}
+	}
+	return images
+}
+
+// equationOnly returns the equation of a paragraph that holds nothing but one inline equation.
+func equationOnly(para *ir.Paragraph) (ir.Run, bool) {
+	var found ir.Run
+	count := 0
+	for _, run := range para.Runs {
+		switch {
+		case run.Style.Math:
+			found = run
+			count++
+		case strings.TrimSpace(run.Text) != "":
+			return ir.Run{}, false
+		}
+	}
+	return found, count == 1
 }
 
 // parseSectionXML parses the section XML content.
 func (p *Parser) parseSectionXML(doc *ir.Document, decoder *xml.Decoder) error {
-	var currentParagraph *ir.Paragraph
+	// Body list paragraphs are grouped into lists once the section is read
+	sectionStart := len(doc.Content)
+
+	// Paragraphs can nest (e.g. inside footnotes or header subLists), so keep a stack
+	var paragraphStack []*ir.Paragraph
+	currentParagraph := func() *ir.Paragraph {
+		if len(paragraphStack) > 0 {
+			return paragraphStack[len(paragraphStack)-1]
+		}
+		return nil
+	}
+
+	// Footnotes, headers, footers and master pages being parsed (innermost last)
+	var subListStack []*subListState
 
 	// Stack-based table handling for nested tables
 	var tableStack []*tableState
@@ -204,12 +330,147 @@ func (p *Parser) parseSectionXML(doc *ir.Document, decoder *xml.Decoder) error {
 
 	// Helper to get current cell
 	getCurrentCell := func() *cellContext {
-		if currentTable != nil {
+		if currentTable != nil && currentTable.subListDepth == len(subListStack) {
 			return currentTable.cell
 		}
 		return nil
 	}
 
+	// Hyperlink fields being parsed (innermost last)
+	var linkStack []*hyperlinkState
+	var fieldBegin *hyperlinkState // hyperlink whose fieldBegin parameters are being read
+	currentLink := func() string {
+		if len(linkStack) > 0 {
+			return linkStack[len(linkStack)-1].url
+		}
+		return ""
+	}
+
+	// Memo fields being parsed (innermost last)
+	var memoStack []*memoState
+	var memoBegin *memoState // memo whose fieldBegin parameters and body are being read
+	currentComment := func() string {
+		for i := len(memoStack) - 1; i >= 0; i-- {
+			if id := memoStack[i].ref.Style.CommentRef; id != "" {
+				return id
+			}
+		}
+		return ""
+	}
+
+	// Character styles of the runs being read (innermost last); runs nest through
+	// footnotes and other sub-lists placed inside a run
+	var runStyles []ir.TextStyle
+
+	// Tracked change in effect (insertBegin/deleteBegin ... insertEnd/deleteEnd)
+	var change ir.ChangeType
+	changeAuthor := ""
+
+	// Click-here fields being parsed (innermost last) and the form object being read
+	var clickHeres []*clickHereState
+	var clickHereBegin *clickHereState // click-here field whose fieldBegin parameters are being read
+	var form *ir.FormField
+	addField := func(field *ir.FormField) {
+		doc.AddField(field)
+		if cell := getCurrentCell(); cell != nil {
+			cell.fields = append(cell.fields, field)
+		}
+	}
+
+	// Objects that may hold a caption (innermost last), and the automatic number being read
+	var objects []*objectState
+	var autoNum *autoNumState
+	if p.numbers == nil {
+		p.numbers = parser.NewAutoNumbers(1, 1, 1)
+	}
+
+	// OLE objects and charts read, added after the body paragraph holding them (and so after
+	// the table when they are in a cell)
+	var embedded []*objectState
+	addEmbedded := func() {
+		for _, object := range embedded {
+			if object.attachment != nil {
+				doc.AddAttachment(object.attachment)
+			}
+			if object.chart != nil {
+				doc.AddTable(object.chart)
+			}
+		}
+		embedded = nil
+	}
+
+	// Equation whose script is being read, and the last script read
+	inEquation := false
+	lastScript := ""
+
+	// Section definition being read (hp:secPr) and the default column gap
+	var section *ir.Section
+	var columnGap int64
+
+	// Body paragraph being read: index of its first block, page break and line layout
+	paraStart := 0
+	paraBreak := false
+	var paraList *parser.ListHead
+	var paraLines []lineSeg
+	inBody := func() bool {
+		return len(paragraphStack) == 1 && currentTable == nil && len(subListStack) == 0
+	}
+
+	// Helper to append text to the current cell or paragraph
+	appendText := func(text string) {
+		for _, c := range clickHeres {
+			c.text.WriteString(text)
+		}
+		if cell := getCurrentCell(); cell != nil {
+			cell.text.WriteString(text)
+		} else {
+			var style ir.TextStyle
+			if len(runStyles) > 0 {
+				style = runStyles[len(runStyles)-1]
+			}
+			style.Link = currentLink()
+			style.Change, style.ChangeAuthor = change, changeAuthor
+			style.Comment = currentComment()
+			appendParagraphText(currentParagraph(), text, style)
+		}
+	}
+
+	// Helper to keep the paragraphs of a sub-list that is not kept apart (e.g. the caption
+	// of an equation) in the cell, sub-list or body holding it
+	keepParagraphs := func(paragraphs []*ir.Paragraph) {
+		for _, para := range paragraphs {
+			if cell := getCurrentCell(); cell != nil {
+				if cell.text.Len() > 0 {
+					cell.text.WriteString("\n")
+				}
+				cell.text.WriteString(para.Text)
+			} else if len(subListStack) > 0 {
+				parent := subListStack[len(subListStack)-1]
+				parent.paragraphs = append(parent.paragraphs, para)
+			} else if currentTable == nil {
+				doc.AddParagraph(para)
+			}
+		}
+	}
+
+	// Helper to start or end a tracked change; table cells hold plain text, so use CriticMarkup directly
+	setChange := func(c ir.ChangeType, tcID string) {
+		if cell := getCurrentCell(); cell != nil {
+			switch {
+			case c == ir.ChangeInsert:
+				cell.text.WriteString("{++")
+			case c == ir.ChangeDelete:
+				cell.text.WriteString("{--")
+			case change == ir.ChangeInsert:
+				cell.text.WriteString("++}")
+			case change == ir.ChangeDelete:
+				cell.text.WriteString("--}")
+			}
+		}
+		change = c
+		changeAuthor = p.changeAuthors[tcID]
+	}
+
 	for {
 		token, err := decoder.Token()
 		if err == io.EOF {
@@ -222,36 +483,88 @@ func (p *Parser) parseSectionXML(doc *ir.Document, decoder *xml.Decoder) error {
 		switch t := token.(type) {
 		case xml.StartElement:
 			localName := t.Name.Local
+			if isCaptionedObject(localName) {
+				objects = append(objects, &objectState{})
+			}
 
 			switch localName {
 			case "p":
-				currentParagraph = ir.NewParagraph("")
-				// styleIDRef attribute is available for future style processing
+				paragraphStack = append(paragraphStack, ir.NewParagraph(""))
+				if cell := getCurrentCell(); cell != nil {
+					cell.paraAlign = p.alignments[attrValue(t, "paraPrIDRef")]
+				}
+				if inBody() {
+					// Outline level of the paragraph shape first, then the style name
+					level := p.outlines[attrValue(t, "paraPrIDRef")]
+					if level == 0 {
+						level = p.styleHeadings[attrValue(t, "styleIDRef")]
+					}
+					paraList = nil
+					if level > 0 {
+						currentParagraph().SetHeading(level)
+					} else if head, ok := p.listHeads[attrValue(t, "paraPrIDRef")]; ok {
+						paraList = &head
+					}
+					paraStart = len(doc.Content)
+					paraLines = nil
+					paraBreak = attrValue(t, "pageBreak") == "1"
+					if paraBreak {
+						doc.AddPageBreak()
+					}
+				}
+
+			case "lineseg":
+				if inBody() {
+					paraLines = append(paraLines, parseLineSeg(t))
+				}
+
+			case "secPr":
+				section = &ir.Section{Orientation: ir.OrientationPortrait}
+				columnGap = attrInt(t, "spaceColumns")
+
+			case "pagePr":
+				if section != nil {
+					setPageSize(section, t)
+				}
+
+			case "margin":
+				if section != nil {
+					setPageMargins(section, t)
+				}
+
+			case "colPr":
+				columns := int(attrInt(t, "colCount"))
+				gap := columnGap
+				if sameGap := attrInt(t, "sameGap"); sameGap > 0 {
+					gap = sameGap
+				}
+				doc.SetColumns(columns, parser.HWPUnitToMM(int32(gap)))
+				p.pages.SetColumns(columns)
+
+			case "run":
+				style := p.charStyles[attrValue(t, "charPrIDRef")]
+				runStyles = append(runStyles, style)
+				if cell := getCurrentCell(); cell != nil {
+					cell.runBold = style.Bold
+				}
 
 			case "t":
 				// Text element - read content
-				if currentParagraph != nil {
+				if currentParagraph() != nil {
 					text, _ := readElementText(decoder)
-					cell := getCurrentCell()
-					if cell != nil {
-						cell.text.WriteString(text)
-					} else {
-						currentParagraph.Text += text
+					if cell := getCurrentCell(); cell != nil && strings.TrimSpace(text) != "" {
+						cell.addFormattedText()
 					}
+					appendText(text)
 				}
 
 			case "tab":
-				if currentParagraph != nil {
-					cell := getCurrentCell()
-					if cell != nil {
-						cell.text.WriteString("\t")
-					} else {
-						currentParagraph.Text += "\t"
-					}
+				if currentParagraph() != nil {
+					appendText("\t")
 				}
 
 			case "br":
-				if currentParagraph != nil {
+				if currentParagraph() != nil {
 					brType := "line"
 					for _, attr := range t.Attr {
 						if attr.Name.Local == "type" {
@@ -259,22 +572,168 @@ func (p *Parser) parseSectionXML(doc *ir.Document, decoder *xml.Decoder) error {
 						}
 					}
 					if brType == "line" {
-						cell := getCurrentCell()
-						if cell != nil {
-							cell.text.WriteString("\n")
-						} else {
-							currentParagraph.Text += "\n"
-						}
+						appendText("\n")
 					}
 				}
 
+			case "footNote", "endNote", "header", "footer", "masterPage", "drawText":
+				// drawText is the text of a drawing object (hp:rect, hp:ellipse, ...)
+				subListStack = append(subListStack, &subListState{kind: localName, scope: pageScope(t)})
+
+			case "equation":
+				inEquation = true
+
+			case "caption":
+				// Caption of a table, picture or other object
+				subListStack = append(subListStack, &subListState{kind: localName})
+
+			case "autoNum":
+				// Picture, table and equation numbers are counted in reading order
+				if kind, ok := numberKind(t); ok {
+					if n := p.numbers.Next(kind); n > 0 {
+						autoNum = &autoNumState{number: n}
+					}
+				}
+
+			case "autoNumFormat":
+				if autoNum != nil {
+					autoNum.prefix = attrValue(t, "prefixChar")
+					autoNum.suffix = attrValue(t, "suffixChar")
+				}
+
+			case "newNum":
+				if kind, ok := numberKind(t); ok {
+					p.numbers.Restart(kind, int(attrInt(t, "num")))
+				}
+
+			case "script":
+				// Equation script (Hancom equation syntax) converted to LaTeX
+				if inEquation && currentParagraph() != nil {
+					script, _ := readElementText(decoder)
+					lastScript = script
+					tex := equation.ToLaTeX(script)
+					if tex == "" {
+						break
+					}
+					if cell := getCurrentCell(); cell != nil {
+						cell.text.WriteString("$" + tex + "$")
+					} else {
+						appendParagraphRun(currentParagraph(), ir.Run{Text: tex, Style: ir.TextStyle{Math: true}})
+					}
+				}
+
+			case "fieldBegin":
+				switch attrValue(t, "type") {
+				case "HYPERLINK", "CROSSREF":
+					fieldBegin = &hyperlinkState{id: attrValue(t, "id"), crossRef: attrValue(t, "type") == "CROSSREF"}
+					linkStack = append(linkStack, fieldBegin)
+				case "BOOKMARK":
+					// Range bookmark; the anchor goes to the block holding it
+					doc.AddAnchor(attrValue(t, "name"))
+				case "MEMO":
+					// The memo body (subList) is collected like a footnote body
+					memoBegin = &memoState{id: attrValue(t, "id")}
+					memoStack = append(memoStack, memoBegin)
+					subListStack = append(subListStack, &subListState{kind: "memo"})
+				case "CLICK_HERE":
+					// Form input field; its value is the text up to fieldEnd
+					clickHereBegin = &clickHereState{
+						id:    attrValue(t, "id"),
+						field: &ir.FormField{Name: attrValue(t, "name"), Type: ir.FormFieldClickHere},
+					}
+					clickHeres = append(clickHeres, clickHereBegin)
+				}
+
+			case "bookmark":
+				doc.AddAnchor(attrValue(t, "name"))
+
+			case "insertBegin", "deleteBegin":
+				if localName == "insertBegin" {
+					setChange(ir.ChangeInsert, attrValue(t, "TcId"))
+				} else {
+					setChange(ir.ChangeDelete, attrValue(t, "TcId"))
+				}
+
+			case "insertEnd", "deleteEnd":
+				setChange("", "")
+
+			case "stringParam":
+				// Memo author and creation time
+				if memoBegin != nil && len(subListStack) > 0 && subListStack[len(subListStack)-1].kind == "memo" {
+					text, _ := readElementText(decoder)
+					switch attrValue(t, "name") {
+					case "Author":
+						memoBegin.author = text
+					case "CreateDateTime":
+						memoBegin.date = text
+					}
+					break
+				}
+				// Guide text of a click-here field
+				if clickHereBegin != nil {
+					text, _ := readElementText(decoder)
+					if attrValue(t, "name") == "Direction" {
+						clickHereBegin.field.Hint = strings.TrimSpace(text)
+					}
+					break
+				}
+				// Hyperlink target: Path holds the plain URL, Command the escaped one
+				// Cross-reference target: RefPath holds the bookmark ("?#name")
+				if fieldBegin != nil {
+					text, _ := readElementText(decoder)
+					switch attrValue(t, "name") {
+					case "Command":
+						fieldBegin.command = text
+					case "Path", "RefPath":
+						fieldBegin.path = text
+					}
+				}
+
+			case "fieldEnd":
+				beginID := attrValue(t, "beginIDRef")
+				for i := len(clickHeres) - 1; i >= 0; i-- {
+					if clickHeres[i].id != beginID {
+						continue
+					}
+					clickHere := clickHeres[i]
+					clickHeres = append(clickHeres[:i], clickHeres[i+1:]...)
+					clickHere.field.Value = strings.TrimSpace(clickHere.text.String())
+					addField(clickHere.field)
+					break
+				}
+				for i := len(memoStack) - 1; i >= 0; i-- {
+					if memoStack[i].id != beginID {
+						continue
+					}
+					memo := memoStack[i]
+					memoStack = append(memoStack[:i], memoStack[i+1:]...)
+					if cell := getCurrentCell(); cell != nil && memo.inCell {
+						cell.text.WriteString("==}{>>" + memo.ref.Text + "<<}")
+					} else if para := currentParagraph(); para != nil {
+						appendParagraphRun(para, memo.ref)
+					}
+					break
+				}
+				for i := len(linkStack) - 1; i >= 0; i-- {
+					if linkStack[i].id != beginID {
+						continue
+					}
+					link := linkStack[i]
+					linkStack = linkStack[:i]
+					if cell := getCurrentCell(); cell != nil && link.inCell {
+						cell.text.WriteString("](" + link.url + ")")
+					}
+					break
+				}
+
 			case "tbl":
 				// Push current table state to stack (if any)
 				if currentTable != nil {
 					tableStack = append(tableStack, currentTable)
 				}
 				// Start new table
-				currentTable = &tableState{}
+				currentTable = &tableState{subListDepth: len(subListStack)}
+				objects[len(objects)-1].table = currentTable
 
 			case "tr":
 				if currentTable != nil {
@@ -283,11 +742,31 @@ func (p *Parser) parseSectionXML(doc *ir.Document, decoder *xml.Decoder) error {
 
 			case "tc":
 				if currentTable != nil {
-					cell := cellContext{colSpan: 1, rowSpan: 1}
+					cell := cellContext{colSpan: 1, rowSpan: 1, row: len(currentTable.rows), col: len(currentTable.currentRow)}
+					cell.style.Background = p.backgrounds[attrValue(t, "borderFillIDRef")]
+					cell.style.IsHeader = attrValue(t, "header") == "1"
 					// Note: colSpan and rowSpan are parsed from child cellSpan element
 					currentTable.cell = &cell
 				}
 
+			case "cellAddr":
+				if cell := getCurrentCell(); cell != nil {
+					cell.row = int(attrInt(t, "rowAddr"))
+					cell.col = int(attrInt(t, "colAddr"))
+				}
+
+			case "checkBtn", "radioBtn", "edit", "comboBox", "listBox":
+				if currentParagraph() != nil {
+					form = parseFormObject(t, formFieldType(localName))
+				}
+
+			case "text":
+				// Text of an edit box
+				if form != nil && form.Type == ir.FormFieldText {
+					text, _ := readElementText(decoder)
+					form.Value = strings.TrimSpace(text)
+				}
+
 			case "cellSpan":
 				// Parse cell span information (colSpan and rowSpan)
 				cell := getCurrentCell()
@@ -307,37 +786,260 @@ func (p *Parser) parseSectionXML(doc *ir.Document, decoder *xml.Decoder) error {
 				}
 
 			case "pic", "img":
-				// Image element
+				// Image element (hc:img inside hp:pic refers to the binary item); the picture
+				// stays in its cell or at its position in the paragraph
+				if n := len(objects); localName == "img" && n > 0 && objects[n-1].image != nil {
+					break // the picture already referred to the binary item
+				}
 				if p.options.ExtractImages {
 					img := p.parseImage(t)
 					if img != nil {
-						doc.AddImage(img)
+						if cell := getCurrentCell(); cell != nil {
+							// Table cells hold plain text, so write the image as Markdown
+							cell.text.WriteString(img.Markdown())
+							cell.images = append(cell.images, img)
+						} else if para := currentParagraph(); para != nil {
+							appendParagraphRun(para, ir.Run{Image: img})
+						} else if currentTable == nil {
+							doc.AddImage(img)
+						}
+						if n := len(objects); n > 0 && objects[n-1].image == nil {
+							objects[n-1].image = img
+						}
+					}
+				}
+
+			case "ole":
+				// Added once the enclosing paragraph or table is done, with the caption read by then
+				object := objects[len(objects)-1]
+				object.attachment, object.chart = p.parseOLE(t)
+
+			case "chart":
+				// Chart data is kept in a separate chart part (e.g. Chart/chart1.xml)
+				if ref := attrValue(t, "chartIDRef"); ref != "" {
+					if data, err := p.extractBinData(ref); err == nil {
+						objects[len(objects)-1].chart = parseChart(ref, data)
 					}
 				}
 			}
 
 		case xml.EndElement:
 			localName := t.Name.Local
+			if isCaptionedObject(localName) && len(objects) > 0 {
+				if object := objects[len(objects)-1]; object.attachment != nil || object.chart != nil {
+					embedded = append(embedded, object)
+				}
+				objects = objects[:len(objects)-1]
+			}
 
 			switch localName {
 			case "p":
-				if currentParagraph != nil && !currentParagraph.IsEmpty() {
+				para := currentParagraph()
+				if para == nil {
+					break
+				}
+				body := inBody()
+				paragraphStack = paragraphStack[:len(paragraphStack)-1]
+				if para.HasAnnotations() {
+					para.Text = ir.RunsText(para.Runs)
+				}
+
+				if !para.IsEmpty() {
 					cell := getCurrentCell()
-					if cell != nil {
+					if cell == nil && len(subListStack) > 0 {
+						// Inside footnote/header - collect its body
+						subList := subListStack[len(subListStack)-1]
+						subList.paragraphs = append(subList.paragraphs, para)
+					} else if cell != nil {
 						// Inside table cell - accumulate text
 						if cell.text.Len() > 0 {
 							cell.text.WriteString("\n")
 						}
-						cell.text.WriteString(currentParagraph.Text)
+						cell.text.WriteString(para.Text)
 					} else if currentTable == nil {
-						// Outside table - add to document
-						doc.AddParagraph(currentParagraph)
+						// Outside table - add to document (a lone equation or pictures on their own
+						// become display blocks)
+						if run, ok := equationOnly(para); ok {
+							doc.AddMath(ir.NewMath(run.Text, lastScript))
+						} else if images := imagesOnly(para); len(images) > 0 {
+							for _, img := range images {
+								doc.AddImage(img)
+							}
+						} else {
+							doc.AddParagraph(para)
+							if body && paraList != nil {
+								p.addListItem(para, *paraList)
+							}
+						}
 					}
 				}
-				currentParagraph = nil
+				if body {
+					addEmbedded()
+					p.placeOnPage(doc, paraStart, paraBreak, paraLines)
+				}
+
+			case "run":
+				if len(runStyles) > 0 {
+					runStyles = runStyles[:len(runStyles)-1]
+				}
+
+			case "secPr":
+				if section != nil {
+					doc.AddSection(section)
+					p.pages.StartSection(1)
+					section = nil
+				}
+
+			case "equation":
+				inEquation = false
+
+			case "caption":
+				if len(subListStack) == 0 || subListStack[len(subListStack)-1].kind != localName {
+					break
+				}
+				caption := subListStack[len(subListStack)-1]
+				subListStack = subListStack[:len(subListStack)-1]
+
+				var object *objectState
+				if len(objects) > 0 {
+					object = objects[len(objects)-1]
+				}
+				switch {
+				case object != nil && object.table != nil:
+					object.table.caption = captionText(caption.paragraphs)
+				case object != nil && object.image != nil:
+					object.image.Caption = captionText(caption.paragraphs)
+				case object != nil && (object.attachment != nil || object.chart != nil):
+					text := captionText(caption.paragraphs)
+					if object.attachment != nil {
+						object.attachment.Caption = text
+					}
+					if object.chart != nil && object.chart.Caption == "" {
+						object.chart.Caption = text
+					}
+					if object.chart != nil && object.chart.ChartImage != nil {
+						object.chart.ChartImage.Caption = text
+					}
+				default:
+					keepParagraphs(caption.paragraphs)
+				}
+
+			case "autoNum":
+				if autoNum != nil && currentParagraph() != nil {
+					appendText(autoNum.text())
+				}
+				autoNum = nil
+
+			case "fieldBegin":
+				clickHereBegin = nil
+				if memoBegin != nil && len(subListStack) > 0 && subListStack[len(subListStack)-1].kind == "memo" {
+					body := subListStack[len(subListStack)-1]
+					subListStack = subListStack[:len(subListStack)-1]
+					memoBegin.ref = doc.AddComment(memoBegin.author, memoBegin.date, body.paragraphs)
+					if cell := getCurrentCell(); cell != nil {
+						cell.text.WriteString("{==")
+						memoBegin.inCell = true
+					}
+					memoBegin = nil
+					break
+				}
+				if fieldBegin != nil {
+					switch {
+					case fieldBegin.crossRef:
+						target := fieldBegin.path
+						if target == "" {
+							target = fieldBegin.command
+						}
+						if id := ir.AnchorID(parser.CrossRefTarget(target)); id != "" {
+							fieldBegin.url = "#" + id
+						}
+					case fieldBegin.path != "":
+						fieldBegin.url = parser.NormalizeLinkURL(fieldBegin.path)
+					default:
+						fieldBegin.url = parser.HyperlinkURL(fieldBegin.command)
+					}
+					// Table cells hold plain text, so write the link as Markdown
+					if cell := getCurrentCell(); cell != nil && fieldBegin.url != "" {
+						cell.text.WriteString("[")
+						fieldBegin.inCell = true
+					}
+					fieldBegin = nil
+				}
+
+			case "header", "footer", "masterPage":
+				if len(subListStack) == 0 {
+					break
+				}
+				subList := subListStack[len(subListStack)-1]
+				subListStack = subListStack[:len(subListStack)-1]
+
+				// Kept apart from the body; identical content from other sections is dropped
+				switch localName {
+				case "header":
+					doc.AddHeader(subList.scope, subList.paragraphs)
+				case "footer":
+					doc.AddFooter(subList.scope, subList.paragraphs)
+				default:
+					doc.AddMasterPage(subList.scope, subList.paragraphs)
+				}
+
+			case "drawText":
+				if len(subListStack) == 0 {
+					break
+				}
+				textBox := subListStack[len(subListStack)-1]
+				subListStack = subListStack[:len(subListStack)-1]
+
+				if cell := getCurrentCell(); cell != nil {
+					// Table cells hold plain text
+					for _, para := range textBox.paragraphs {
+						if cell.text.Len() > 0 {
+							cell.text.WriteString("\n")
+						}
+						cell.text.WriteString(para.Text)
+					}
+				} else if len(subListStack) > 0 {
+					// Text box inside a footnote/header - keep its text in the enclosing body
+					parent := subListStack[len(subListStack)-1]
+					parent.paragraphs = append(parent.paragraphs, textBox.paragraphs...)
+				} else if currentTable == nil {
+					// Split the anchoring paragraph so the text box keeps its position
+					if para := currentParagraph(); para != nil && !para.IsEmpty() {
+						doc.AddParagraph(para)
+						paragraphStack[len(paragraphStack)-1] = ir.NewParagraph("")
+					}
+					doc.AddTextBox(ir.NewTextBox(textBox.paragraphs))
+				}
+
+			case "footNote", "endNote":
+				if len(subListStack) == 0 {
+					break
+				}
+				note := subListStack[len(subListStack)-1]
+				subListStack = subListStack[:len(subListStack)-1]
+
+				ref := doc.AddFootnote(note.kind == "endNote", note.paragraphs)
+				if cell := getCurrentCell(); cell != nil {
+					// Table cells hold plain text, so use the GFM marker directly
+					cell.text.WriteString("[^" + ref.Style.FootnoteRef + "]")
+				} else if para := currentParagraph(); para != nil {
+					appendParagraphRun(para, ref)
+				}
+
+			case "checkBtn", "radioBtn", "edit", "comboBox", "listBox":
+				if form != nil {
+					// The value stays in the text as well
+					addField(form)
+					appendText(form.InlineText())
+					form = nil
+				}
 
 			case "tc":
 				if currentTable != nil && currentTable.cell != nil {
+					cell := currentTable.cell
+					for _, field := range cell.fields {
+						field.Cell = &ir.CellRef{Row: cell.row, Col: cell.col}
+					}
 					currentTable.currentRow = append(currentTable.currentRow, *currentTable.cell)
 					currentTable.cell = nil
 				}
@@ -351,23 +1053,39 @@ func (p *Parser) parseSectionXML(doc *ir.Document, decoder *xml.Decoder) error {
 			case "tbl":
 				if currentTable != nil && len(currentTable.rows) > 0 {
 					// Check if this is a nested table
-					if len(tableStack) > 0 {
-						// Convert nested table to text and add to parent cell
-						nestedText := p.convertTableToText(currentTable.rows)
+					if len(tableStack) > 0 && tableStack[len(tableStack)-1].subListDepth == currentTable.subListDepth {
+						// Keep the nested table in the parent cell; the cell text holds its flattened text too
+						nested := p.buildTable(currentTable.rows)
 						// Pop parent table from stack
 						parentTable := tableStack[len(tableStack)-1]
 						tableStack = tableStack[:len(tableStack)-1]
-						// Add nested table text to parent cell
-						if parentTable.cell != nil {
+						if parentTable.cell != nil && nested != nil {
+							nested.Caption = currentTable.caption
+							parentTable.cell.tables = append(parentTable.cell.tables, nested)
 							if parentTable.cell.text.Len() > 0 {
 								parentTable.cell.text.WriteString("\n")
 							}
-							parentTable.cell.text.WriteString(nestedText)
+							// Rows end with a line break, so the text after the table starts on a new line
+							parentTable.cell.text.WriteString(nested.PlainText() + "\n")
 						}
 						currentTable = parentTable
+					} else if currentTable.subListDepth > 0 {
+						// Table inside footnote/header - keep as text in its body
+						subList := subListStack[len(subListStack)-1]
+						if table := p.buildTable(currentTable.rows); table != nil {
+							subList.paragraphs = append(subList.paragraphs, ir.NewParagraph(table.PlainText()))
+						}
+						currentTable = nil
+						if len(tableStack) > 0 {
+							currentTable = tableStack[len(tableStack)-1]
+							tableStack = tableStack[:len(tableStack)-1]
+						}
 					} else {
 						// Top-level table - add to document
 						table := p.buildTable(currentTable.rows)
+						if table != nil {
+							table.Caption = currentTable.caption
+						}
 						doc.AddTable(table)
 						currentTable = nil
 					}
@@ -384,38 +1102,60 @@ func (p *Parser) parseSectionXML(doc *ir.Document, decoder *xml.Decoder) error {
 		}
 	}
 
+	addEmbedded()
+	p.groupLists(doc, sectionStart)
 	return nil
 }
 
-// convertTableToText converts a table to text format for nested table handling.
-// This is used when a table is found inside another table cell.
-func (p *Parser) convertTableToText(rows [][]cellContext) string {
-	var sb strings.Builder
-
-	for _, row := range rows {
-		for i, cell := range row {
-			text := strings.TrimSpace(cell.text.String())
-			if text == "" {
-				continue
-			}
-			if i > 0 {
-				sb.WriteString(" | ")
-			}
-			// Replace newlines with spaces for inline display
-			text = strings.ReplaceAll(text, "\n", " ")
-			sb.WriteString(text)
+// attrValue returns the value of the named attribute, or "" if missing.
+func attrValue(elem xml.StartElement, name string) string {
+	for _, attr := range elem.Attr {
+		if attr.Name.Local == name {
+			return attr.Value
 		}
-		sb.WriteString("\n")
 	}
-
-	return sb.String()
+	return ""
 }
 
 // cellContext holds temporary cell data during parsing.
 type cellContext struct {
-	text    strings.Builder
-	colSpan int
-	rowSpan int
+	text     strings.Builder
+	colSpan  int
+	rowSpan  int
+	row, col int              // cell address (hp:cellAddr)
+	fields   []*ir.FormField  // form fields in the cell, located once the cell address is read
+	tables   []*ir.TableBlock // tables nested in the cell
+	images   []*ir.ImageBlock // pictures in the cell
+
+	// Formatting: fill and header flag of the cell, alignment of the paragraph being read,
+	// boldness of the run being read and the number of bold and plain text runs
+	style     ir.CellStyle
+	paraAlign string
+	runBold   bool
+	aligned   bool
+	boldRuns  int
+	plainRuns int
+}
+
+// addFormattedText records the formatting of a non-empty text run in the cell.
+// The cell takes the alignment of its first paragraph with text.
+func (c *cellContext) addFormattedText() {
+	if !c.aligned {
+		c.style.Alignment = c.paraAlign
+		c.aligned = true
+	}
+	if c.runBold {
+		c.boldRuns++
+	} else {
+		c.plainRuns++
+	}
+}
+
+// cellStyle returns the IR style of the cell; it is bold if all of its text is bold.
+func (c *cellContext) cellStyle() ir.CellStyle {
+	style := c.style
+	style.Bold = c.boldRuns > 0 && c.plainRuns == 0
+	return style
 }
 
 // buildTable constructs an IR table from parsed rows.
@@ -494,6 +1234,9 @@ func (p *Parser) buildTable(rows [][]cellContext) *ir.TableBlock {
 			table.Cells[rowIdx][colIdx].Text = strings.TrimSpace(cell.text.String())
 			table.Cells[rowIdx][colIdx].ColSpan = cell.colSpan
 			table.Cells[rowIdx][colIdx].RowSpan = cell.rowSpan
+			table.Cells[rowIdx][colIdx].Style = cell.cellStyle()
+			table.Cells[rowIdx][colIdx].Tables = cell.tables
+			table.Cells[rowIdx][colIdx].Images = cell.images
 
 			// Mark cells occupied by this cell's rowSpan and colSpan
 			for r := rowIdx; r < rowIdx+cell.rowSpan && r < numRows; r++ {
@@ -507,10 +1250,8 @@ func (p *Parser) buildTable(rows [][]cellContext) *ir.TableBlock {
 		}
 	}
 
-	// Check if first row might be header
-	if len(rows) > 1 {
-		table.SetHeaderRow()
-	}
+	// Header row and column from the cell formatting
+	table.DetectHeaders()
 
 	return table
 }
@@ -521,7 +1262,7 @@ func (p *Parser) parseImage(elem xml.StartElement) *ir.ImageBlock {
 
 	for _, attr := range elem.Attr {
 		switch attr.Name.Local {
-		case "binItemIDRef", "binItemId":
+		case "binaryItemIDRef", "binItemIDRef", "binItemId":
 			img.ID = attr.Value
 			if path, ok := p.binData[attr.Value]; ok {
 				img.Path = path
@@ -551,6 +1292,54 @@ func (p *Parser) parseImage(elem xml.StartElement) *ir.ImageBlock {
 	return img
 }
 
+// parseOLE converts an embedded OLE object (hp:ole) that refers to a BinData item.
+// Charts become tables of their series values, and the embedded file becomes an
+// attachment when extraction is enabled. Either result may be nil.
+func (p *Parser) parseOLE(elem xml.StartElement) (*ir.AttachmentBlock, *ir.TableBlock) {
+	id := attrValue(elem, "binaryItemIDRef")
+	path, ok := p.binData[id]
+	if !ok {
+		return nil, nil
+	}
+	data, err := p.extractBinData(path)
+	if err != nil {
+		return nil, nil
+	}
+	payload, err := ole.Parse(data)
+	if err != nil {
+		return nil, nil
+	}
+
+	var attachment *ir.AttachmentBlock
+	if p.options.ExtractAttachments {
+		attachment = payload.Attachment(id)
+		if p.options.AttachmentDir != "" {
+			_ = attachment.Save(p.options.AttachmentDir)
+		}
+	}
+	var table *ir.TableBlock
+	if payload.Chart != nil {
+		table = parseChart(id, payload.Chart)
+		if table != nil && p.options.ExtractImages {
+			// The picture shown in the document stays with the table
+			table.ChartImage = payload.PreviewImage(id)
+		}
+	}
+	return attachment, table
+}
+
+// parseChart decodes chart XML into a table of series values that refers to the chart object.
+// It returns nil if the chart cannot be read.
+func parseChart(id string, data []byte) *ir.TableBlock {
+	c, err := chart.Parse(data)
+	if err != nil {
+		return nil
+	}
+	table := c.Table()
+	table.Chart = id
+	return table
+}
+
 // extractBinData reads binary data from the HWPX archive.
 func (p *Parser) extractBinData(path string) ([]byte, error) {
 	for _, f := range p.reader.File {
@@ -3,10 +3,14 @@ package hwpx
 import (
 	"archive/zip"
 	"bytes"
+	"encoding/xml"
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/roboco-io/hwp2md/internal/ir"
 	"github.com/roboco-io/hwp2md/internal/parser"
 )
 
@@ -104,6 +108,190 @@ func TestNew_InvalidPath(t *testing.T) {
 	}
 }
 
+// buildZip builds an in-memory archive from file names and contents.
+func buildZip(t *testing.T, files map[string]string) *zip.Reader {
+	t.Helper()
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, content := range files {
+		addZipFile(t, w, name, []byte(content))
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("failed to close zip: %v", err)
+	}
+	r, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("failed to open zip: %v", err)
+	}
+	return r
+}
+
+func TestLoadPackage(t *testing.T) {
+	// Twelve sections listed out of order; the spine gives the reading order
+	var items, spine strings.Builder
+	files := map[string]string{
+		"META-INF/container.xml": `<ocf:container xmlns:ocf="urn:oasis:names:tc:opendocument:xmlns:container"><ocf:rootfiles>
+  <ocf:rootfile full-path="Contents/content.hpf" media-type="application/hwpml-package+xml"/>
+  <ocf:rootfile full-path="Preview/PrvText.txt" media-type="text/plain"/>
+</ocf:rootfiles></ocf:container>`,
+		"version.xml": `<hv:HCFVersion xmlns:hv="http://www.hancom.co.kr/hwpml/2011/version" tagetApplication="WORDPROCESSOR"
+  major="5" minor="1" micro="0" buildNumber="1" os="1" xmlVersion="1.4" application="Hancom Office Hangul" appVersion="11, 0, 0, 6402"/>`,
+		"settings.xml": `<ha:HWPApplicationSetting xmlns:ha="http://www.hancom.co.kr/hwpml/2011/app">
+  <ha:CaretPosition listIDRef="0" paraIDRef="3" pos="47"/></ha:HWPApplicationSetting>`,
+		"Contents/header.xml":      `<hh:head xmlns:hh="http://www.hancom.co.kr/hwpml/2011/head"/>`,
+		"BinData/image1.png":       "png",
+		"Contents/masterpage0.xml": "<masterPage/>",
+	}
+	items.WriteString(`<opf:item id="header" href="Contents/header.xml" media-type="application/xml"/>`)
+	items.WriteString(`<opf:item id="image1" href="BinData/image1.png" media-type="image/png"/>`)
+	items.WriteString(`<opf:item id="masterpage0" href="Contents/masterpage0.xml" media-type="application/xml"/>`)
+	spine.WriteString(`<opf:itemref idref="header" linear="no"/>`)
+	for i := 11; i >= 0; i-- {
+		items.WriteString(fmt.Sprintf(`<opf:item id="section%d" href="section%d.xml" media-type="application/xml"/>`, i, i))
+		files[fmt.Sprintf("Contents/section%d.xml", i)] = "<hs:sec/>"
+	}
+	for i := 0; i < 12; i++ {
+		spine.WriteString(fmt.Sprintf(`<opf:itemref idref="section%d" linear="yes"/>`, i))
+	}
+	files["Contents/content.hpf"] = `<opf:package xmlns:opf="http://www.idpf.org/2007/opf/"><opf:metadata><opf:title>보고서</opf:title>
+  <opf:meta name="creator" content="text">작성자</opf:meta><opf:meta name="CreatedDate" content="text">2024-01-02T03:04:05Z</opf:meta></opf:metadata>
+  <opf:manifest>` + items.String() + `</opf:manifest><opf:spine>` + spine.String() + `</opf:spine></opf:package>`
+
+	pkg, err := LoadPackage(buildZip(t, files))
+	if err != nil {
+		t.Fatalf("LoadPackage failed: %v", err)
+	}
+
+	if len(pkg.Sections) != 12 {
+		t.Fatalf("expected 12 sections, got %d: %v", len(pkg.Sections), pkg.Sections)
+	}
+	for i, section := range pkg.Sections {
+		// Hrefs relative to the package document are resolved against its directory
+		if want := fmt.Sprintf("Contents/section%d.xml", i); section != want {
+			t.Errorf("section %d: expected %s, got %s", i, want, section)
+		}
+	}
+	if len(pkg.MasterPages) != 1 || pkg.BinData["image1"] != "BinData/image1.png" {
+		t.Errorf("unexpected parts: master pages %v, bin data %v", pkg.MasterPages, pkg.BinData)
+	}
+	if pkg.Header != "Contents/header.xml" {
+		t.Errorf("unexpected header part: %q", pkg.Header)
+	}
+
+	if pkg.Version == nil || pkg.Version.String() != "5.1.0.1" || pkg.Version.TargetApplication != "WORDPROCESSOR" {
+		t.Errorf("unexpected version: %+v", pkg.Version)
+	}
+	if pkg.Settings == nil || pkg.Settings.CaretPosition == nil || pkg.Settings.CaretPosition.Pos != 47 {
+		t.Errorf("unexpected settings: %+v", pkg.Settings)
+	}
+
+	meta := pkg.Manifest.ToMetadata()
+	if meta.Title != "보고서" || meta.Author != "작성자" || meta.Created != "2024-01-02T03:04:05Z" {
+		t.Errorf("unexpected metadata: %+v", meta)
+	}
+}
+
+func TestLoadPackage_WithoutManifest(t *testing.T) {
+	files := map[string]string{
+		"Contents/section10.xml": "<hs:sec/>",
+		"Contents/section2.xml":  "<hs:sec/>",
+		"Contents/section1.xml":  "<hs:sec/>",
+		"BinData/image1.jpg":     "jpg",
+	}
+	pkg, err := LoadPackage(buildZip(t, files))
+	if err != nil {
+		t.Fatalf("LoadPackage failed: %v", err)
+	}
+
+	expected := []string{"Contents/section1.xml", "Contents/section2.xml", "Contents/section10.xml"}
+	if strings.Join(pkg.Sections, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected sections %v, got %v", expected, pkg.Sections)
+	}
+	if pkg.BinData["image1"] != "BinData/image1.jpg" {
+		t.Errorf("unexpected bin data: %v", pkg.BinData)
+	}
+	if pkg.Version != nil || pkg.Settings != nil || pkg.Header != "" || len(pkg.Warnings) != 0 {
+		t.Error("expected no version, settings, header and warnings")
+	}
+}
+
+func TestLoadPackage_Header(t *testing.T) {
+	// The header part is found through the manifest, relative to the package document
+	files := map[string]string{
+		"Contents/content.hpf": `<opf:package xmlns:opf="http://www.idpf.org/2007/opf/"><opf:manifest>
+  <opf:item id="header" href="styles/head.xml" media-type="application/xml"/>
+  <opf:item id="section0" href="Contents/section0.xml" media-type="application/xml"/>
+</opf:manifest><opf:spine><opf:itemref idref="section0"/></opf:spine></opf:package>`,
+		"Contents/styles/head.xml": `<hh:head xmlns:hh="http://www.hancom.co.kr/hwpml/2011/head"/>`,
+		"Contents/section0.xml":    "<hs:sec/>",
+	}
+	pkg, err := LoadPackage(buildZip(t, files))
+	if err != nil {
+		t.Fatalf("LoadPackage failed: %v", err)
+	}
+	if pkg.Header != "Contents/styles/head.xml" {
+		t.Errorf("unexpected header part: %q", pkg.Header)
+	}
+}
+
+func TestLoadPackage_Malformed(t *testing.T) {
+	manifest := func(spine string) string {
+		return `<opf:package xmlns:opf="http://www.idpf.org/2007/opf/"><opf:manifest>
+  <opf:item id="section0" href="Contents/section0.xml" media-type="application/xml"/>
+</opf:manifest><opf:spine>` + spine + `</opf:spine></opf:package>`
+	}
+	section := "<hs:sec/>"
+
+	tests := []struct {
+		name    string
+		files   map[string]string
+		message string
+	}{
+		{"broken container", map[string]string{"META-INF/container.xml": "<ocf:container>"}, "container.xml"},
+		{"no package rootfile", map[string]string{
+			"META-INF/container.xml": `<container><rootfiles><rootfile full-path="Preview/PrvText.txt" media-type="text/plain"/></rootfiles></container>`,
+		}, "no package document"},
+		{"missing package document", map[string]string{
+			"META-INF/container.xml": `<container><rootfiles><rootfile full-path="Contents/content.hpf" media-type="application/hwpml-package+xml"/></rootfiles></container>`,
+		}, "package document not found"},
+		{"broken manifest", map[string]string{"Contents/content.hpf": "<opf:package>"}, "failed to parse manifest"},
+		{"unknown spine item", map[string]string{
+			"Contents/content.hpf":  manifest(`<opf:itemref idref="section9"/>`),
+			"Contents/section0.xml": section,
+		}, `unknown item "section9"`},
+		{"missing section", map[string]string{"Contents/content.hpf": manifest(`<opf:itemref idref="section0"/>`)}, "section not found"},
+	}
+
+	for _, tc := range tests {
+		_, err := LoadPackage(buildZip(t, tc.files))
+		if err == nil {
+			t.Errorf("%s: expected an error", tc.name)
+			continue
+		}
+		if !strings.Contains(err.Error(), tc.message) {
+			t.Errorf("%s: expected error containing %q, got %v", tc.name, tc.message, err)
+		}
+	}
+
+	// Broken optional parts are left out with a warning instead of failing the package
+	pkg, err := LoadPackage(buildZip(t, map[string]string{
+		"Contents/content.hpf":  manifest(`<opf:itemref idref="section0"/>`),
+		"Contents/section0.xml": section,
+		"version.xml":           "<hv:HCFVersion",
+		"settings.xml":          "<ha:HWPApplicationSetting",
+	}))
+	if err != nil {
+		t.Fatalf("expected broken version.xml and settings.xml to be left out, got %v", err)
+	}
+	if pkg.Version != nil || pkg.Settings != nil || len(pkg.Sections) != 1 {
+		t.Errorf("unexpected package: %+v", pkg)
+	}
+	if len(pkg.Warnings) != 2 || !strings.Contains(pkg.Warnings[0].Error(), "version.xml") ||
+		!strings.Contains(pkg.Warnings[1].Error(), "settings.xml") {
+		t.Errorf("expected warnings for both parts, got %v", pkg.Warnings)
+	}
+}
+
 // createTestHWPX creates a minimal valid HWPX file for testing.
 func createTestHWPX(t *testing.T) string {
 	t.Helper()
@@ -189,6 +377,9 @@ func TestParser_Parse(t *testing.T) {
 	if doc == nil {
 		t.Fatal("expected non-nil document")
 	}
+	if p.Version() != nil || p.GetVersion() != "" {
+		t.Errorf("expected no version without version.xml, got %q", p.GetVersion())
+	}
 
 	if len(doc.Content) != 2 {
 		t.Errorf("expected 2 content blocks, got %d", len(doc.Content))
@@ -202,6 +393,48 @@ func TestParser_Parse(t *testing.T) {
 	}
 }
 
+func TestParser_Version(t *testing.T) {
+	hwpxPath := filepath.Join(t.TempDir(), "version.hwpx")
+	f, err := os.Create(hwpxPath)
+	if err != nil {
+		t.Fatalf("failed to create temp file: %v", err)
+	}
+
+	w := zip.NewWriter(f)
+	addZipFile(t, w, "content.hpf", []byte(`<opf:package xmlns:opf="http://www.idpf.org/2007/opf/"><opf:metadata>
+  <opf:meta name="creator" content="text">작성자</opf:meta></opf:metadata><opf:manifest>
+  <opf:item id="section0" href="Contents/section0.xml" media-type="application/xml"/>
+</opf:manifest><opf:spine><opf:itemref idref="section0"/></opf:spine></opf:package>`))
+	addZipFile(t, w, "Contents/section0.xml", []byte("<hs:sec/>"))
+	addZipFile(t, w, "version.xml", []byte(`<hv:HCFVersion xmlns:hv="http://www.hancom.co.kr/hwpml/2011/version"
+  major="5" minor="1" micro="1" buildNumber="0" application="Hancom Office Hangul"/>`))
+	addZipFile(t, w, "settings.xml", []byte(`<ha:HWPApplicationSetting xmlns:ha="http://www.hancom.co.kr/hwpml/2011/app">
+  <ha:CaretPosition listIDRef="0" paraIDRef="0" pos="5"/></ha:HWPApplicationSetting>`))
+	w.Close()
+	f.Close()
+
+	p, err := New(hwpxPath, parser.Options{})
+	if err != nil {
+		t.Fatalf("failed to create parser: %v", err)
+	}
+	defer p.Close()
+
+	if p.GetVersion() != "5.1.1.0" || p.Version().Application != "Hancom Office Hangul" {
+		t.Errorf("unexpected version: %q, %+v", p.GetVersion(), p.Version())
+	}
+	if p.Settings() == nil || p.Settings().CaretPosition == nil || p.Settings().CaretPosition.Pos != 5 {
+		t.Errorf("unexpected settings: %+v", p.Settings())
+	}
+
+	doc, err := p.Parse()
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	if doc.Metadata.Creator != "HWP 5.1.1.0" || doc.Metadata.Author != "작성자" {
+		t.Errorf("unexpected metadata: %+v", doc.Metadata)
+	}
+}
+
 func TestParser_ParseWithTable(t *testing.T) {
 	tmpDir := t.TempDir()
 	hwpxPath := filepath.Join(tmpDir, "table.hwpx")
@@ -276,6 +509,751 @@ func TestParser_ParseWithTable(t *testing.T) {
 	}
 }
 
+func TestParser_ParseWithChart(t *testing.T) {
+	tmpDir := t.TempDir()
+	hwpxPath := filepath.Join(tmpDir, "chart.hwpx")
+
+	f, err := os.Create(hwpxPath)
+	if err != nil {
+		t.Fatalf("failed to create temp file: %v", err)
+	}
+
+	w := zip.NewWriter(f)
+	addZipFile(t, w, "content.hpf", []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<opf:package xmlns:opf="http://www.idpf.org/2007/opf/">
+  <opf:manifest>
+    <opf:item id="section0" href="Contents/section0.xml" media-type="application/xml"/>
+  </opf:manifest>
+</opf:package>`))
+	addZipFile(t, w, "Contents/section0.xml", []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<hs:sec xmlns:hs="http://www.hancom.co.kr/hwpml/2011/section"
+        xmlns:hp="http://www.hancom.co.kr/hwpml/2011/paragraph">
+  <hp:p><hp:run><hp:chart id="1" chartIDRef="Chart/chart1.xml"/></hp:run></hp:p>
+  <hp:p><hp:run><hp:t>예산 </hp:t><hp:chart id="2" chartIDRef="Chart/chart1.xml"><hp:caption><hp:subList>
+    <hp:p><hp:run><hp:t>예산 비율</hp:t></hp:run></hp:p>
+  </hp:subList></hp:caption></hp:chart><hp:t>참고</hp:t></hp:run></hp:p>
+  <hp:p><hp:run><hp:tbl rowCnt="1" colCnt="1"><hp:tr><hp:tc><hp:subList>
+    <hp:p><hp:run><hp:chart id="3" chartIDRef="Chart/chart1.xml"/></hp:run></hp:p>
+  </hp:subList></hp:tc></hp:tr></hp:tbl></hp:run></hp:p>
+</hs:sec>`))
+	addZipFile(t, w, "Chart/chart1.xml", []byte(`<c:chartSpace xmlns:c="http://schemas.openxmlformats.org/drawingml/2006/chart">
+  <c:chart><c:plotArea><c:pieChart><c:ser>
+    <c:tx><c:v>비율</c:v></c:tx>
+    <c:cat><c:strLit><c:pt idx="0"><c:v>인건비</c:v></c:pt><c:pt idx="1"><c:v>운영비</c:v></c:pt></c:strLit></c:cat>
+    <c:val><c:numLit><c:pt idx="0"><c:v>60</c:v></c:pt><c:pt idx="1"><c:v>40</c:v></c:pt></c:numLit></c:val>
+  </c:ser></c:pieChart></c:plotArea></c:chart>
+</c:chartSpace>`))
+	w.Close()
+	f.Close()
+
+	p, err := New(hwpxPath, parser.Options{})
+	if err != nil {
+		t.Fatalf("failed to create parser: %v", err)
+	}
+	defer p.Close()
+
+	doc, err := p.Parse()
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+
+	if len(doc.Content) != 5 {
+		t.Fatalf("expected 5 blocks, got %+v", doc.Content)
+	}
+	table := doc.Content[0].Table
+	if table == nil || table.Chart != "Chart/chart1.xml" {
+		t.Fatalf("expected chart table block, got %+v", doc.Content[0])
+	}
+	if table.Rows != 3 || table.Cells[0][1].Text != "비율" || table.Cells[2][0].Text != "운영비" || table.Cells[2][1].Text != "40" {
+		t.Errorf("unexpected chart table: %+v", table.Cells)
+	}
+
+	// A chart inside a paragraph follows the paragraph text and takes its caption
+	if para := doc.Content[1].Paragraph; para == nil || para.Text != "예산 참고" {
+		t.Errorf("expected the paragraph text first, got %+v", doc.Content[1])
+	}
+	if table := doc.Content[2].Table; table == nil || table.Chart == "" || table.Caption != "예산 비율" {
+		t.Errorf("expected captioned chart table, got %+v", doc.Content[2])
+	}
+
+	// A chart inside a table cell follows the table
+	if table := doc.Content[3].Table; table == nil || table.Chart != "" {
+		t.Errorf("expected the enclosing table, got %+v", doc.Content[3])
+	}
+	if table := doc.Content[4].Table; table == nil || table.Chart == "" {
+		t.Errorf("expected chart table after the table, got %+v", doc.Content[4])
+	}
+}
+
+func TestParseSectionXML_Footnote(t *testing.T) {
+	sectionContent := `<hs:sec xmlns:hs="http://www.hancom.co.kr/hwpml/2011/section"
+        xmlns:hp="http://www.hancom.co.kr/hwpml/2011/paragraph">
+  <hp:p><hp:run><hp:t>본문</hp:t><hp:ctrl><hp:footNote number="1"><hp:subList>
+    <hp:p><hp:run><hp:t>각주 내용</hp:t></hp:run></hp:p>
+  </hp:subList></hp:footNote></hp:ctrl><hp:t> 계속</hp:t></hp:run></hp:p>
+  <hp:p><hp:run><hp:t>끝</hp:t><hp:ctrl><hp:endNote number="1"><hp:subList>
+    <hp:p><hp:run><hp:t>미주 내용</hp:t></hp:run></hp:p>
+  </hp:subList></hp:endNote></hp:ctrl></hp:run></hp:p>
+</hs:sec>`
+
+	p := &Parser{}
+	doc := ir.NewDocument()
+	if err := p.parseSectionXML(doc, xml.NewDecoder(strings.NewReader(sectionContent))); err != nil {
+		t.Fatalf("failed to parse section: %v", err)
+	}
+
+	if len(doc.Content) != 2 {
+		t.Fatalf("expected 2 paragraphs, got %d", len(doc.Content))
+	}
+
+	para := doc.Content[0].Paragraph
+	if para.Text != "본문 계속" {
+		t.Errorf("expected '본문 계속', got %q", para.Text)
+	}
+	if len(para.Runs) != 3 || para.Runs[1].Style.FootnoteRef != "1" {
+		t.Fatalf("expected footnote ref in second run, got %+v", para.Runs)
+	}
+
+	if len(doc.Footnotes) != 2 {
+		t.Fatalf("expected 2 notes, got %d", len(doc.Footnotes))
+	}
+	if doc.Footnotes[0].Text() != "각주 내용" {
+		t.Errorf("expected footnote '각주 내용', got %q", doc.Footnotes[0].Text())
+	}
+	if !doc.Footnotes[1].Endnote || doc.Footnotes[1].ID != "e1" {
+		t.Errorf("expected endnote e1, got %+v", doc.Footnotes[1])
+	}
+}
+
+func TestParseSectionXML_HeaderFooter(t *testing.T) {
+	sectionContent := `<hs:sec xmlns:hs="http://www.hancom.co.kr/hwpml/2011/section"
+        xmlns:hp="http://www.hancom.co.kr/hwpml/2011/paragraph">
+  <hp:p><hp:run><hp:ctrl><hp:header id="1" applyPageType="ODD"><hp:subList>
+    <hp:p><hp:run><hp:t>머리말</hp:t></hp:run></hp:p>
+  </hp:subList></hp:header></hp:ctrl><hp:t>본문</hp:t></hp:run></hp:p>
+  <hp:p><hp:run><hp:ctrl><hp:footer id="2" applyPageType="BOTH"><hp:subList>
+    <hp:p><hp:run><hp:t>- 1 -</hp:t></hp:run></hp:p>
+  </hp:subList></hp:footer></hp:ctrl></hp:run></hp:p>
+</hs:sec>`
+
+	p := &Parser{}
+	doc := ir.NewDocument()
+	if err := p.parseSectionXML(doc, xml.NewDecoder(strings.NewReader(sectionContent))); err != nil {
+		t.Fatalf("failed to parse section: %v", err)
+	}
+
+	if len(doc.Content) != 1 || doc.Content[0].Paragraph.Text != "본문" {
+		t.Fatalf("expected only the body paragraph, got %+v", doc.Content)
+	}
+	if len(doc.Headers) != 1 || doc.Headers[0].Scope != ir.PageScopeOdd || doc.Headers[0].Text() != "머리말" {
+		t.Errorf("unexpected headers: %+v", doc.Headers)
+	}
+	if len(doc.Footers) != 1 || doc.Footers[0].Text() != "- 1 -" {
+		t.Errorf("unexpected footers: %+v", doc.Footers)
+	}
+}
+
+func TestParseSectionXML_PictureHeader(t *testing.T) {
+	sectionContent := `<hs:sec xmlns:hs="http://www.hancom.co.kr/hwpml/2011/section"
+        xmlns:hp="http://www.hancom.co.kr/hwpml/2011/paragraph"
+        xmlns:hc="http://www.hancom.co.kr/hwpml/2011/core">
+  <hp:p><hp:run><hp:ctrl><hp:header id="1" applyPageType="BOTH"><hp:subList>
+    <hp:p><hp:run><hp:pic><hc:img binaryItemIDRef="logo"/></hp:pic></hp:run></hp:p>
+  </hp:subList></hp:header></hp:ctrl><hp:t>본문</hp:t></hp:run></hp:p>
+  <hp:p><hp:run><hp:ctrl><hp:header id="2" applyPageType="BOTH"><hp:subList>
+    <hp:p><hp:run><hp:pic><hc:img binaryItemIDRef="logo"/></hp:pic></hp:run></hp:p>
+  </hp:subList></hp:header></hp:ctrl></hp:run></hp:p>
+</hs:sec>`
+
+	p := &Parser{options: parser.Options{ExtractImages: true}}
+	doc := ir.NewDocument()
+	if err := p.parseSectionXML(doc, xml.NewDecoder(strings.NewReader(sectionContent))); err != nil {
+		t.Fatalf("failed to parse section: %v", err)
+	}
+
+	// The logo stays in the header, not in the body
+	if len(doc.Content) != 1 || doc.Content[0].Paragraph == nil || doc.Content[0].Paragraph.Text != "본문" {
+		t.Fatalf("expected only the body paragraph, got %+v", doc.Content)
+	}
+	// The repeated logo header is a duplicate
+	if len(doc.Headers) != 1 || doc.Headers[0].Text() != "![logo](logo)" {
+		t.Fatalf("expected one picture header, got %+v", doc.Headers)
+	}
+}
+
+func TestParseSectionXML_Hyperlink(t *testing.T) {
+	sectionContent := `<hs:sec xmlns:hs="http://www.hancom.co.kr/hwpml/2011/section"
+        xmlns:hp="http://www.hancom.co.kr/hwpml/2011/paragraph">
+  <hp:p><hp:run><hp:t>온라인(</hp:t><hp:ctrl><hp:fieldBegin id="7" type="HYPERLINK"><hp:parameters>
+    <hp:stringParam name="Command">https\://www.example.com;1;0;0;</hp:stringParam>
+  </hp:parameters></hp:fieldBegin></hp:ctrl><hp:t>예시</hp:t><hp:ctrl><hp:fieldEnd beginIDRef="7"/></hp:ctrl><hp:t>) 참고</hp:t></hp:run></hp:p>
+  <hp:tbl><hp:tr><hp:tc><hp:p><hp:run><hp:ctrl><hp:fieldBegin id="8" type="HYPERLINK"><hp:parameters>
+    <hp:stringParam name="Path">www.example.com</hp:stringParam>
+  </hp:parameters></hp:fieldBegin></hp:ctrl><hp:t>링크</hp:t><hp:ctrl><hp:fieldEnd beginIDRef="8"/></hp:ctrl></hp:run></hp:p></hp:tc></hp:tr></hp:tbl>
+</hs:sec>`
+
+	p := &Parser{}
+	doc := ir.NewDocument()
+	if err := p.parseSectionXML(doc, xml.NewDecoder(strings.NewReader(sectionContent))); err != nil {
+		t.Fatalf("failed to parse section: %v", err)
+	}
+
+	if len(doc.Content) != 2 {
+		t.Fatalf("expected paragraph and table, got %d blocks", len(doc.Content))
+	}
+
+	para := doc.Content[0].Paragraph
+	if para.Text != "온라인(예시) 참고" {
+		t.Errorf("expected '온라인(예시) 참고', got %q", para.Text)
+	}
+	if len(para.Runs) != 3 || para.Runs[1].Text != "예시" || para.Runs[1].Style.Link != "https://www.example.com" {
+		t.Errorf("unexpected runs: %+v", para.Runs)
+	}
+
+	cell := doc.Content[1].Table.Cells[0][0].Text
+	if cell != "[링크](http://www.example.com)" {
+		t.Errorf("expected Markdown link in cell, got %q", cell)
+	}
+}
+
+func TestParseSectionXML_MemoAndTrackChanges(t *testing.T) {
+	sectionContent := `<hs:sec xmlns:hs="http://www.hancom.co.kr/hwpml/2011/section"
+        xmlns:hp="http://www.hancom.co.kr/hwpml/2011/paragraph">
+  <hp:p><hp:run><hp:t>기한은 </hp:t><hp:deleteBegin Id="1" TcId="1"/><hp:t>30일</hp:t><hp:deleteEnd Id="1" TcId="1"/>
+    <hp:insertBegin Id="2" TcId="2"/><hp:t>60일</hp:t><hp:insertEnd Id="2" TcId="2"/><hp:t>로 </hp:t>
+    <hp:ctrl><hp:fieldBegin id="5" type="MEMO"><hp:parameters>
+      <hp:stringParam name="Author">검토자</hp:stringParam>
+    </hp:parameters><hp:subList><hp:p><hp:run><hp:t>근거 확인</hp:t></hp:run></hp:p></hp:subList></hp:fieldBegin></hp:ctrl>
+    <hp:t>한다</hp:t><hp:ctrl><hp:fieldEnd beginIDRef="5"/></hp:ctrl><hp:t>.</hp:t></hp:run></hp:p>
+  <hp:tbl><hp:tr><hp:tc><hp:p><hp:run><hp:insertBegin Id="3" TcId="2"/><hp:t>신설</hp:t><hp:insertEnd Id="3" TcId="2"/></hp:run></hp:p></hp:tc></hp:tr></hp:tbl>
+</hs:sec>`
+
+	p := &Parser{changeAuthors: map[string]string{"2": "홍길동"}}
+	doc := ir.NewDocument()
+	if err := p.parseSectionXML(doc, xml.NewDecoder(strings.NewReader(sectionContent))); err != nil {
+		t.Fatalf("failed to parse section: %v", err)
+	}
+
+	if len(doc.Content) != 2 {
+		t.Fatalf("expected paragraph and table, got %d blocks", len(doc.Content))
+	}
+
+	para := doc.Content[0].Paragraph
+	expected := "기한은 {--30일--}{++60일++}로 {==한다==}{>>검토자: 근거 확인<<}."
+	if para.Text != expected {
+		t.Errorf("paragraph text = %q, want %q", para.Text, expected)
+	}
+	for _, run := range para.Runs {
+		if run.Style.Change == ir.ChangeInsert && run.Style.ChangeAuthor != "홍길동" {
+			t.Errorf("expected change author, got %+v", run)
+		}
+	}
+	if len(doc.Comments) != 1 || doc.Comments[0].Author != "검토자" || doc.Comments[0].Text() != "근거 확인" {
+		t.Errorf("unexpected comments: %+v", doc.Comments)
+	}
+
+	if cell := doc.Content[1].Table.Cells[0][0].Text; cell != "{++신설++}" {
+		t.Errorf("expected CriticMarkup in cell, got %q", cell)
+	}
+}
+
+func TestParseHeader_ChangeAuthors(t *testing.T) {
+	headerXML := `<hh:head xmlns:hh="http://www.hancom.co.kr/hwpml/2011/head"><hh:refList>
+  <hh:trackChanges itemCnt="1"><hh:trackChange type="Insert" date="2024-05-01T10:00:00Z" authorID="1" id="2"/></hh:trackChanges>
+  <hh:trackChangeAuthors itemCnt="1"><hh:trackChangeAuthor name="홍길동" mark="1" id="1"/></hh:trackChangeAuthors>
+</hh:refList></hh:head>`
+
+	header, err := ParseHeader([]byte(headerXML))
+	if err != nil {
+		t.Fatalf("failed to parse header: %v", err)
+	}
+	if authors := header.ChangeAuthors(); authors["2"] != "홍길동" {
+		t.Errorf("unexpected change authors: %v", authors)
+	}
+}
+
+func TestParseSectionXML_BookmarkAndCrossRef(t *testing.T) {
+	sectionContent := `<hs:sec xmlns:hs="http://www.hancom.co.kr/hwpml/2011/section"
+        xmlns:hp="http://www.hancom.co.kr/hwpml/2011/paragraph">
+  <hp:p><hp:run><hp:ctrl><hp:bookmark name="별표 3"/></hp:ctrl><hp:t>[별표 3] 서식</hp:t></hp:run></hp:p>
+  <hp:p><hp:run><hp:ctrl><hp:fieldBegin id="9" type="CROSSREF"><hp:parameters>
+    <hp:stringParam name="RefPath">?#별표 3</hp:stringParam>
+  </hp:parameters></hp:fieldBegin></hp:ctrl><hp:t>별표 3</hp:t><hp:ctrl><hp:fieldEnd beginIDRef="9"/></hp:ctrl><hp:t> 참조</hp:t></hp:run></hp:p>
+</hs:sec>`
+
+	p := &Parser{}
+	doc := ir.NewDocument()
+	if err := p.parseSectionXML(doc, xml.NewDecoder(strings.NewReader(sectionContent))); err != nil {
+		t.Fatalf("failed to parse section: %v", err)
+	}
+
+	if len(doc.Content) != 2 {
+		t.Fatalf("expected 2 paragraphs, got %d blocks", len(doc.Content))
+	}
+	if anchors := doc.Content[0].Anchors; len(anchors) != 1 || anchors[0] != "별표-3" {
+		t.Errorf("unexpected anchors: %v", anchors)
+	}
+	runs := doc.Content[1].Paragraph.Runs
+	if len(runs) != 2 || runs[0].Style.Link != "#별표-3" {
+		t.Errorf("expected cross-reference link, got %+v", runs)
+	}
+}
+
+func TestParseSectionXML_Equation(t *testing.T) {
+	sectionContent := `<hs:sec xmlns:hs="http://www.hancom.co.kr/hwpml/2011/section"
+        xmlns:hp="http://www.hancom.co.kr/hwpml/2011/paragraph">
+  <hp:p><hp:run><hp:t>넓이는 </hp:t><hp:equation id="1"><hp:sz width="100" height="100"/><hp:script>pi r^2</hp:script></hp:equation><hp:t> 이다</hp:t></hp:run></hp:p>
+  <hp:p><hp:run><hp:equation id="2"><hp:script>{a} over {b}</hp:script></hp:equation></hp:run></hp:p>
+</hs:sec>`
+
+	p := &Parser{}
+	doc := ir.NewDocument()
+	if err := p.parseSectionXML(doc, xml.NewDecoder(strings.NewReader(sectionContent))); err != nil {
+		t.Fatalf("failed to parse section: %v", err)
+	}
+
+	if len(doc.Content) != 2 {
+		t.Fatalf("expected paragraph and math block, got %d blocks", len(doc.Content))
+	}
+
+	para := doc.Content[0].Paragraph
+	if para.Text != "넓이는 $\\pi r^{2}$ 이다" {
+		t.Errorf("unexpected paragraph text: %q", para.Text)
+	}
+	if len(para.Runs) != 3 || !para.Runs[1].Style.Math || para.Runs[1].Text != "\\pi r^{2}" {
+		t.Errorf("unexpected runs: %+v", para.Runs)
+	}
+
+	math := doc.Content[1]
+	if math.Type != ir.BlockTypeMath || math.Math.TeX != "\\frac{a}{b}" || math.Math.Script != "{a} over {b}" {
+		t.Errorf("expected display math block, got %+v", math.Math)
+	}
+}
+
+func TestParseSectionXML_TextBox(t *testing.T) {
+	sectionContent := `<hs:sec xmlns:hs="http://www.hancom.co.kr/hwpml/2011/section"
+        xmlns:hp="http://www.hancom.co.kr/hwpml/2011/paragraph">
+  <hp:p><hp:run><hp:t>앞</hp:t><hp:rect id="1"><hp:drawText><hp:subList>
+    <hp:p><hp:run><hp:t>핵심 요약</hp:t></hp:run></hp:p>
+  </hp:subList></hp:drawText></hp:rect><hp:t>뒤</hp:t></hp:run></hp:p>
+  <hp:tbl><hp:tr><hp:tc><hp:p><hp:run><hp:t>셀</hp:t><hp:ellipse><hp:drawText><hp:subList>
+    <hp:p><hp:run><hp:t>상자</hp:t></hp:run></hp:p>
+  </hp:subList></hp:drawText></hp:ellipse></hp:run></hp:p></hp:tc></hp:tr></hp:tbl>
+</hs:sec>`
+
+	p := &Parser{}
+	doc := ir.NewDocument()
+	if err := p.parseSectionXML(doc, xml.NewDecoder(strings.NewReader(sectionContent))); err != nil {
+		t.Fatalf("failed to parse section: %v", err)
+	}
+
+	if len(doc.Content) != 4 {
+		t.Fatalf("expected paragraph, text box, paragraph and table, got %d blocks", len(doc.Content))
+	}
+	if doc.Content[0].Paragraph.Text != "앞" || doc.Content[2].Paragraph.Text != "뒤" {
+		t.Errorf("unexpected paragraphs: %q, %q", doc.Content[0].Paragraph.Text, doc.Content[2].Paragraph.Text)
+	}
+	textBox := doc.Content[1]
+	if textBox.Type != ir.BlockTypeTextBox || textBox.TextBox.Paragraphs[0].Text != "핵심 요약" {
+		t.Errorf("expected text box block, got %+v", textBox)
+	}
+	if cell := doc.Content[3].Table.Cells[0][0].Text; cell != "셀\n상자" {
+		t.Errorf("expected text box text in cell, got %q", cell)
+	}
+}
+
+func TestParseSectionXML_PageLayout(t *testing.T) {
+	sectionContent := `<hs:sec xmlns:hs="http://www.hancom.co.kr/hwpml/2011/section"
+        xmlns:hp="http://www.hancom.co.kr/hwpml/2011/paragraph">
+  <hp:p><hp:run><hp:secPr spaceColumns="1134">
+    <hp:pagePr landscape="NARROWLY" width="59528" height="84186">
+      <hp:margin header="4252" footer="4252" gutter="0" left="5669" right="5669" top="2834" bottom="2834"/>
+    </hp:pagePr></hp:secPr>
+    <hp:ctrl><hp:colPr type="NEWSPAPER" colCount="1" sameGap="0"/></hp:ctrl><hp:t>첫 쪽</hp:t></hp:run>
+    <hp:linesegarray><hp:lineseg textpos="0" vertpos="0" flags="393216"/></hp:linesegarray></hp:p>
+  <hp:p><hp:run><hp:tbl><hp:tr><hp:tc><hp:subList><hp:p><hp:run><hp:t>셀</hp:t></hp:run>
+    <hp:linesegarray><hp:lineseg textpos="0" vertpos="0"/></hp:linesegarray></hp:p></hp:subList></hp:tc></hp:tr></hp:tbl></hp:run>
+    <hp:linesegarray><hp:lineseg textpos="0" vertpos="1500"/><hp:lineseg textpos="1" vertpos="0"/></hp:linesegarray></hp:p>
+  <hp:p pageBreak="1"><hp:run><hp:t>셋째 쪽 </hp:t><hp:edit name="값"><hp:text>입력</hp:text></hp:edit></hp:run>
+    <hp:linesegarray><hp:lineseg textpos="0" vertpos="0"/></hp:linesegarray></hp:p>
+</hs:sec>`
+
+	p := &Parser{}
+	doc := ir.NewDocument()
+	if err := p.parseSectionXML(doc, xml.NewDecoder(strings.NewReader(sectionContent))); err != nil {
+		t.Fatalf("failed to parse section: %v", err)
+	}
+
+	if len(doc.Sections) != 1 {
+		t.Fatalf("expected 1 section, got %d", len(doc.Sections))
+	}
+	section := doc.Sections[0]
+	if section.Orientation != ir.OrientationLandscape || section.Width != 297 || section.Height != 210 {
+		t.Errorf("unexpected paper: %+v", section)
+	}
+	if section.Margins.Left != 20 || section.Margins.Top != 10 || section.Margins.Header != 15 || section.Columns != 1 {
+		t.Errorf("unexpected margins or columns: %+v", section)
+	}
+
+	if len(doc.Content) != 3 {
+		t.Fatalf("expected paragraph, table and paragraph, got %d blocks", len(doc.Content))
+	}
+	// Lines inside cells are not counted; the page changes at the second line of the table paragraph
+	pages := []int{1, 1, 3}
+	for i, block := range doc.Content {
+		if block.Page != pages[i] {
+			t.Errorf("block %d: expected page %d, got %d", i, pages[i], block.Page)
+		}
+	}
+	if !doc.Content[2].PageBreak || doc.Content[1].PageBreak {
+		t.Errorf("expected page break before the last paragraph only")
+	}
+	// A field read before the paragraph's lines takes the page of its block
+	if len(doc.Fields) != 1 || doc.Fields[0].Block != 2 || doc.Fields[0].Page != 3 {
+		t.Errorf("expected the field on page 3, got %+v", doc.Fields)
+	}
+}
+
+func TestParseSectionXML_FormFields(t *testing.T) {
+	sectionContent := `<hs:sec xmlns:hs="http://www.hancom.co.kr/hwpml/2011/section"
+        xmlns:hp="http://www.hancom.co.kr/hwpml/2011/paragraph">
+  <hp:p><hp:run><hp:t>성명: </hp:t><hp:ctrl><hp:fieldBegin id="7" type="CLICK_HERE" name="성명">
+    <hp:parameters><hp:stringParam name="Direction">이름을 입력하세요</hp:stringParam></hp:parameters>
+  </hp:fieldBegin></hp:ctrl><hp:t>홍길동</hp:t><hp:ctrl><hp:fieldEnd beginIDRef="7"/></hp:ctrl></hp:run></hp:p>
+  <hp:tbl><hp:tr>
+    <hp:tc><hp:subList><hp:p><hp:run><hp:t>동의 여부</hp:t></hp:run></hp:p></hp:subList>
+      <hp:cellAddr colAddr="0" rowAddr="0"/></hp:tc>
+    <hp:tc><hp:subList><hp:p><hp:run><hp:checkBtn name="동의" captionText="동의함" value="CHECKED"/></hp:run></hp:p></hp:subList>
+      <hp:cellAddr colAddr="1" rowAddr="0"/></hp:tc>
+  </hp:tr></hp:tbl>
+  <hp:p><hp:run><hp:t>주소: </hp:t><hp:edit name="주소"><hp:text>서울 종로</hp:text></hp:edit></hp:run></hp:p>
+</hs:sec>`
+
+	p := &Parser{}
+	doc := ir.NewDocument()
+	if err := p.parseSectionXML(doc, xml.NewDecoder(strings.NewReader(sectionContent))); err != nil {
+		t.Fatalf("failed to parse section: %v", err)
+	}
+
+	if len(doc.Fields) != 3 {
+		t.Fatalf("expected 3 form fields, got %d", len(doc.Fields))
+	}
+	clickHere := doc.Fields[0]
+	if clickHere.Name != "성명" || clickHere.Type != ir.FormFieldClickHere || clickHere.Value != "홍길동" ||
+		clickHere.Hint != "이름을 입력하세요" || clickHere.Block != 0 {
+		t.Errorf("unexpected click-here field: %+v", clickHere)
+	}
+	checkBox := doc.Fields[1]
+	if checkBox.Type != ir.FormFieldCheckBox || !checkBox.Checked() || checkBox.Caption != "동의함" ||
+		checkBox.Block != 1 || checkBox.Cell == nil || checkBox.Cell.Row != 0 || checkBox.Cell.Col != 1 {
+		t.Errorf("unexpected check box: %+v", checkBox)
+	}
+	if edit := doc.Fields[2]; edit.Type != ir.FormFieldText || edit.Value != "서울 종로" || edit.Block != 2 || edit.Cell != nil {
+		t.Errorf("unexpected edit box: %+v", edit)
+	}
+
+	// Values stay in the text as well
+	if text := doc.Content[0].Paragraph.Text; text != "성명: 홍길동" {
+		t.Errorf("unexpected click-here paragraph: %q", text)
+	}
+	if cell := doc.Content[1].Table.Cells[0][1].Text; cell != "☑ 동의함" {
+		t.Errorf("unexpected check box cell: %q", cell)
+	}
+	if text := doc.Content[2].Paragraph.Text; text != "주소: 서울 종로" {
+		t.Errorf("unexpected edit paragraph: %q", text)
+	}
+}
+
+func TestParseSectionXML_Captions(t *testing.T) {
+	sectionContent := `<hs:sec xmlns:hs="http://www.hancom.co.kr/hwpml/2011/section"
+        xmlns:hp="http://www.hancom.co.kr/hwpml/2011/paragraph">
+  <hp:p><hp:run><hp:tbl rowCnt="1" colCnt="1">
+    <hp:caption side="TOP"><hp:subList><hp:p><hp:run><hp:t>표 </hp:t><hp:ctrl><hp:autoNum num="1" numType="TABLE">
+      <hp:autoNumFormat type="DIGIT" suffixChar="."/></hp:autoNum></hp:ctrl><hp:t> 인구 현황</hp:t></hp:run></hp:p></hp:subList></hp:caption>
+    <hp:tr><hp:tc><hp:subList><hp:p><hp:run><hp:t>셀</hp:t></hp:run></hp:p></hp:subList></hp:tc></hp:tr>
+  </hp:tbl></hp:run></hp:p>
+  <hp:p><hp:run><hp:pic binItemIDRef="image1"><hp:img binaryItemIDRef="image1"/>
+    <hp:caption side="BOTTOM"><hp:subList><hp:p><hp:run><hp:t>그림 </hp:t><hp:ctrl><hp:autoNum num="1" numType="PICTURE"/></hp:ctrl><hp:t> 지도</hp:t></hp:run></hp:p></hp:subList></hp:caption>
+  </hp:pic></hp:run></hp:p>
+  <hp:p><hp:run><hp:ctrl><hp:newNum num="7" numType="TABLE"/></hp:ctrl><hp:tbl rowCnt="1" colCnt="1">
+    <hp:caption><hp:subList><hp:p><hp:run><hp:t>표 </hp:t><hp:ctrl><hp:autoNum numType="TABLE"/></hp:ctrl></hp:run></hp:p></hp:subList></hp:caption>
+    <hp:tr><hp:tc><hp:subList><hp:p><hp:run><hp:t>셀</hp:t></hp:run></hp:p></hp:subList></hp:tc></hp:tr>
+  </hp:tbl></hp:run></hp:p>
+  <hp:p><hp:run><hp:equation><hp:script>a+b</hp:script>
+    <hp:caption><hp:subList><hp:p><hp:run><hp:t>수식 </hp:t><hp:ctrl><hp:autoNum numType="EQUATION"/></hp:ctrl></hp:run></hp:p></hp:subList></hp:caption>
+  </hp:equation></hp:run></hp:p>
+</hs:sec>`
+
+	header, err := ParseHeader([]byte(`<hh:head xmlns:hh="http://www.hancom.co.kr/hwpml/2011/head">
+  <hh:beginNum page="1" footnote="1" endnote="1" pic="1" tbl="3" equation="1"/></hh:head>`))
+	if err != nil {
+		t.Fatalf("failed to parse header: %v", err)
+	}
+
+	p := &Parser{numbers: header.AutoNumbers(), options: parser.Options{ExtractImages: true}, binData: map[string]string{}}
+	doc := ir.NewDocument()
+	if err := p.parseSectionXML(doc, xml.NewDecoder(strings.NewReader(sectionContent))); err != nil {
+		t.Fatalf("failed to parse section: %v", err)
+	}
+
+	var tables []*ir.TableBlock
+	var images []*ir.ImageBlock
+	var texts []string
+	for _, block := range doc.Content {
+		switch {
+		case block.Table != nil:
+			tables = append(tables, block.Table)
+		case block.Image != nil:
+			images = append(images, block.Image)
+		case block.Paragraph != nil:
+			texts = append(texts, block.Paragraph.Text)
+		}
+	}
+	if len(tables) != 2 {
+		t.Fatalf("expected 2 tables, got %d", len(tables))
+	}
+	if tables[0].Caption != "표 3. 인구 현황" {
+		t.Errorf("unexpected first table caption: %q", tables[0].Caption)
+	}
+	if tables[1].Caption != "표 7" {
+		t.Errorf("unexpected renumbered table caption: %q", tables[1].Caption)
+	}
+	if cell := tables[0].Cells[0][0].Text; cell != "셀" {
+		t.Errorf("caption leaked into the table: %q", cell)
+	}
+	if len(images) != 1 || images[0].Caption != "그림 1 지도" {
+		t.Errorf("expected a picture with its caption, got %+v", images)
+	}
+
+	// Captions of other objects stay in the text
+	if len(texts) != 1 || texts[0] != "수식 1" {
+		t.Errorf("unexpected paragraphs: %q", texts)
+	}
+}
+
+func TestParseSectionXML_CellFormatting(t *testing.T) {
+	headerXML := `<hh:head xmlns:hh="http://www.hancom.co.kr/hwpml/2011/head"
+        xmlns:hc="http://www.hancom.co.kr/hwpml/2011/core"><hh:refList>
+  <hh:borderFills itemCnt="2">
+    <hh:borderFill id="1"><hc:fillBrush><hc:winBrush faceColor="none" hatchColor="#999999" alpha="0"/></hc:fillBrush></hh:borderFill>
+    <hh:borderFill id="2"><hc:fillBrush><hc:winBrush faceColor="#D9D9D9" hatchColor="#999999" alpha="0"/></hc:fillBrush></hh:borderFill>
+  </hh:borderFills>
+  <hh:charProperties itemCnt="2">
+    <hh:charPr id="0" height="1000"/>
+    <hh:charPr id="1" height="1000"><hh:bold/></hh:charPr>
+  </hh:charProperties>
+  <hh:paraProperties itemCnt="3">
+    <hh:paraPr id="0"><hh:align horizontal="JUSTIFY" vertical="BASELINE"/></hh:paraPr>
+    <hh:paraPr id="1"><hh:align horizontal="CENTER" vertical="BASELINE"/></hh:paraPr>
+    <hh:paraPr id="2"><hh:align horizontal="RIGHT" vertical="BASELINE"/></hh:paraPr>
+  </hh:paraProperties>
+</hh:refList></hh:head>`
+
+	header, err := ParseHeader([]byte(headerXML))
+	if err != nil {
+		t.Fatalf("failed to parse header: %v", err)
+	}
+	if bg := header.Backgrounds(); len(bg) != 1 || bg["2"] != "#D9D9D9" {
+		t.Errorf("unexpected backgrounds: %v", bg)
+	}
+
+	sectionContent := `<hs:sec xmlns:hs="http://www.hancom.co.kr/hwpml/2011/section"
+        xmlns:hp="http://www.hancom.co.kr/hwpml/2011/paragraph">
+  <hp:p><hp:run><hp:tbl rowCnt="3" colCnt="2">
+    <hp:tr>
+      <hp:tc borderFillIDRef="2"><hp:subList><hp:p paraPrIDRef="1"><hp:run charPrIDRef="0"><hp:t>구분</hp:t></hp:run></hp:p></hp:subList></hp:tc>
+      <hp:tc borderFillIDRef="2"><hp:subList><hp:p paraPrIDRef="1"><hp:run charPrIDRef="0"><hp:t>금액</hp:t></hp:run></hp:p></hp:subList></hp:tc>
+    </hp:tr>
+    <hp:tr>
+      <hp:tc borderFillIDRef="1"><hp:subList><hp:p paraPrIDRef="0"><hp:run charPrIDRef="1"><hp:t>수입</hp:t></hp:run></hp:p></hp:subList></hp:tc>
+      <hp:tc borderFillIDRef="1"><hp:subList><hp:p paraPrIDRef="2"><hp:run charPrIDRef="0"><hp:t>100</hp:t></hp:run></hp:p></hp:subList></hp:tc>
+    </hp:tr>
+    <hp:tr>
+      <hp:tc borderFillIDRef="1"><hp:subList><hp:p paraPrIDRef="0"><hp:run charPrIDRef="1"><hp:t>지출</hp:t></hp:run></hp:p></hp:subList></hp:tc>
+      <hp:tc borderFillIDRef="1"><hp:subList><hp:p paraPrIDRef="2"><hp:run charPrIDRef="0"><hp:t>80</hp:t></hp:run><hp:run charPrIDRef="1"/></hp:p></hp:subList></hp:tc>
+    </hp:tr>
+  </hp:tbl></hp:run></hp:p>
+</hs:sec>`
+
+	p := &Parser{
+		backgrounds: header.Backgrounds(),
+		charStyles:  header.TextStyles(),
+		alignments:  header.Alignments(),
+	}
+	doc := ir.NewDocument()
+	if err := p.parseSectionXML(doc, xml.NewDecoder(strings.NewReader(sectionContent))); err != nil {
+		t.Fatalf("failed to parse section: %v", err)
+	}
+	if len(doc.Content) != 1 || doc.Content[0].Table == nil {
+		t.Fatalf("expected one table, got %+v", doc.Content)
+	}
+
+	table := doc.Content[0].Table
+	if !table.HasHeader || !table.HeaderColumn {
+		t.Errorf("expected header row and column, got row=%v column=%v", table.HasHeader, table.HeaderColumn)
+	}
+	if style := table.Cells[0][0].Style; style.Background != "#D9D9D9" || style.Alignment != ir.AlignCenter {
+		t.Errorf("unexpected header cell style: %+v", style)
+	}
+	if style := table.Cells[2][1].Style; style.Bold || style.Alignment != ir.AlignRight {
+		t.Errorf("empty bold run should not make the cell bold: %+v", style)
+	}
+	if aligns := table.ColumnAlignments(); aligns[1] != ir.AlignRight {
+		t.Errorf("unexpected column alignments: %q", aligns)
+	}
+}
+
+func TestParseHeader_Styles(t *testing.T) {
+	headerXML := `<hh:head xmlns:hh="http://www.hancom.co.kr/hwpml/2011/head"><hh:refList>
+  <hh:charProperties itemCnt="3">
+    <hh:charPr id="0" shadeColor="none"><hh:underline type="NONE" shape="SOLID"/><hh:strikeout shape="NONE"/></hh:charPr>
+    <hh:charPr id="1" shadeColor="#FFFF00"><hh:bold/><hh:italic/><hh:underline type="BOTTOM" shape="SOLID"/><hh:strikeout shape="SOLID"/></hh:charPr>
+    <hh:charPr id="2" shadeColor="#FFFFFF"><hh:underline type="NONE" shape="SOLID"/><hh:strikeout shape="3D"/><hh:supscript/></hh:charPr>
+  </hh:charProperties>
+  <hh:paraProperties itemCnt="3">
+    <hh:paraPr id="0"><hh:heading type="NONE" idRef="0" level="0"/></hh:paraPr>
+    <hh:paraPr id="1"><hh:heading type="OUTLINE" idRef="0" level="1"/></hh:paraPr>
+    <hh:paraPr id="2"><hh:heading type="BULLET" idRef="1" level="0"/></hh:paraPr>
+  </hh:paraProperties>
+  <hh:styles itemCnt="3">
+    <hh:style id="0" type="PARA" name="바탕글" engName="Normal" paraPrIDRef="0" charPrIDRef="0"/>
+    <hh:style id="1" type="PARA" name="개요 3" engName="Outline 3" paraPrIDRef="0" charPrIDRef="0"/>
+    <hh:style id="2" type="CHAR" name="제목" engName="" paraPrIDRef="0" charPrIDRef="1"/>
+  </hh:styles>
+</hh:refList></hh:head>`
+
+	header, err := ParseHeader([]byte(headerXML))
+	if err != nil {
+		t.Fatalf("failed to parse header: %v", err)
+	}
+
+	styles := header.TextStyles()
+	if styles["0"] != (ir.TextStyle{}) {
+		t.Errorf("expected plain style for charPr 0, got %+v", styles["0"])
+	}
+	expected := ir.TextStyle{Bold: true, Italic: true, Underline: true, Strikethrough: true, Highlight: "#FFFF00"}
+	if styles["1"] != expected {
+		t.Errorf("unexpected style for charPr 1: %+v", styles["1"])
+	}
+	if styles["2"] != (ir.TextStyle{Superscript: true}) {
+		t.Errorf("unexpected style for charPr 2: %+v", styles["2"])
+	}
+
+	if levels := header.OutlineLevels(); len(levels) != 1 || levels["1"] != 2 {
+		t.Errorf("unexpected outline levels: %v", levels)
+	}
+	if levels := header.StyleHeadingLevels(); len(levels) != 1 || levels["1"] != 3 {
+		t.Errorf("unexpected style heading levels: %v", levels)
+	}
+}
+
+func TestParseSectionXML_StyledRuns(t *testing.T) {
+	sectionContent := `<hs:sec xmlns:hs="http://www.hancom.co.kr/hwpml/2011/section"
+        xmlns:hp="http://www.hancom.co.kr/hwpml/2011/paragraph">
+  <hp:p paraPrIDRef="1" styleIDRef="0"><hp:run charPrIDRef="0"><hp:t>개요 제목</hp:t></hp:run></hp:p>
+  <hp:p paraPrIDRef="0" styleIDRef="1"><hp:run charPrIDRef="0"><hp:t>스타일 제목</hp:t></hp:run></hp:p>
+  <hp:p paraPrIDRef="0" styleIDRef="0">
+    <hp:run charPrIDRef="0"><hp:t>일반 </hp:t></hp:run>
+    <hp:run charPrIDRef="1"><hp:t>강조</hp:t><hp:ctrl><hp:footNote><hp:subList>
+      <hp:p><hp:run charPrIDRef="0"><hp:t>각주</hp:t></hp:run></hp:p>
+    </hp:subList></hp:footNote></hp:ctrl><hp:t> 계속</hp:t></hp:run>
+    <hp:run charPrIDRef="2"><hp:t>기울임</hp:t></hp:run>
+  </hp:p>
+</hs:sec>`
+
+	p := &Parser{
+		charStyles: map[string]ir.TextStyle{
+			"0": {},
+			"1": {Bold: true},
+			"2": {Italic: true},
+		},
+		outlines:      map[string]int{"1": 1},
+		styleHeadings: map[string]int{"1": 2},
+	}
+	doc := ir.NewDocument()
+	if err := p.parseSectionXML(doc, xml.NewDecoder(strings.NewReader(sectionContent))); err != nil {
+		t.Fatalf("failed to parse section: %v", err)
+	}
+	if len(doc.Content) != 3 {
+		t.Fatalf("expected 3 paragraphs, got %d", len(doc.Content))
+	}
+
+	if level := doc.Content[0].Paragraph.Style.HeadingLevel; level != 1 {
+		t.Errorf("expected outline heading level 1, got %d", level)
+	}
+	if level := doc.Content[1].Paragraph.Style.HeadingLevel; level != 2 {
+		t.Errorf("expected style heading level 2, got %d", level)
+	}
+
+	para := doc.Content[2].Paragraph
+	if para.Style.HeadingLevel != 0 {
+		t.Errorf("expected body paragraph, got heading level %d", para.Style.HeadingLevel)
+	}
+	if para.Text != "일반 강조 계속기울임" {
+		t.Errorf("unexpected paragraph text: %q", para.Text)
+	}
+	var bold, italic []string
+	for _, run := range para.Runs {
+		if run.Style.Bold {
+			bold = append(bold, run.Text)
+		}
+		if run.Style.Italic {
+			italic = append(italic, run.Text)
+		}
+	}
+	// The run style is kept after the footnote inside the run
+	if strings.Join(bold, "|") != "강조| 계속" {
+		t.Errorf("unexpected bold runs: %q", bold)
+	}
+	if strings.Join(italic, "|") != "기울임" {
+		t.Errorf("unexpected italic runs: %q", italic)
+	}
+}
+
+func TestParseSectionXML_NestedTable(t *testing.T) {
+	sectionContent := `<hs:sec xmlns:hs="http://www.hancom.co.kr/hwpml/2011/section"
+        xmlns:hp="http://www.hancom.co.kr/hwpml/2011/paragraph">
+  <hp:p><hp:run><hp:tbl rowCnt="1" colCnt="2"><hp:tr>
+    <hp:tc><hp:subList><hp:p><hp:run><hp:t>구분</hp:t></hp:run></hp:p></hp:subList><hp:cellSpan colSpan="1" rowSpan="1"/></hp:tc>
+    <hp:tc><hp:subList>
+      <hp:p><hp:run><hp:t>세부 내역</hp:t></hp:run></hp:p>
+      <hp:p><hp:run><hp:tbl rowCnt="2" colCnt="2">
+        <hp:tr><hp:tc header="1"><hp:subList><hp:p><hp:run><hp:t>항목</hp:t></hp:run></hp:p></hp:subList><hp:cellSpan colSpan="2" rowSpan="1"/></hp:tc></hp:tr>
+        <hp:tr>
+          <hp:tc><hp:subList><hp:p><hp:run><hp:t>A</hp:t></hp:run></hp:p></hp:subList><hp:cellSpan colSpan="1" rowSpan="1"/></hp:tc>
+          <hp:tc><hp:subList><hp:p><hp:run><hp:t>B</hp:t></hp:run></hp:p></hp:subList><hp:cellSpan colSpan="1" rowSpan="1"/></hp:tc>
+        </hp:tr>
+      </hp:tbl></hp:run></hp:p>
+    </hp:subList><hp:cellSpan colSpan="1" rowSpan="1"/></hp:tc>
+  </hp:tr></hp:tbl></hp:run></hp:p>
+</hs:sec>`
+
+	p := &Parser{}
+	doc := ir.NewDocument()
+	if err := p.parseSectionXML(doc, xml.NewDecoder(strings.NewReader(sectionContent))); err != nil {
+		t.Fatalf("failed to parse section: %v", err)
+	}
+	if len(doc.Content) != 1 || doc.Content[0].Table == nil {
+		t.Fatalf("expected one top-level table, got %+v", doc.Content)
+	}
+
+	cell := doc.Content[0].Table.Cells[0][1]
+	if len(cell.Tables) != 1 {
+		t.Fatalf("expected a nested table in the cell, got %d", len(cell.Tables))
+	}
+	nested := cell.Tables[0]
+	if nested.Rows != 2 || nested.Cols != 2 || nested.Cells[0][0].ColSpan != 2 {
+		t.Errorf("nested table lost its layout: %+v", nested)
+	}
+	if !nested.HasHeader {
+		t.Error("expected the nested table to keep its header row")
+	}
+	// The cell text still carries the flattened table for plain renderers
+	if cell.Text != "세부 내역\n항목\nA | B" {
+		t.Errorf("unexpected cell text: %q", cell.Text)
+	}
+}
+
 func TestReadElementText(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -326,3 +1304,141 @@ func TestCellContext(t *testing.T) {
 		t.Errorf("expected 'Test content', got %s", cell.text.String())
 	}
 }
+
+func TestParseSectionXML_Lists(t *testing.T) {
+	headerXML := `<hh:head xmlns:hh="http://www.hancom.co.kr/hwpml/2011/head"><hh:refList>
+  <hh:numberings itemCnt="1"><hh:numbering id="1" start="0">
+    <hh:paraHead start="1" level="1" numFormat="HANGUL_SYLLABLE">^1.</hh:paraHead>
+    <hh:paraHead start="1" level="2" numFormat="CIRCLED_DIGIT">^2</hh:paraHead>
+    <hh:paraHead start="1" level="3" numFormat="ROMAN_SMALL">^1.^3)</hh:paraHead>
+  </hh:numbering></hh:numberings>
+  <hh:bullets itemCnt="1"><hh:bullet id="1" char="•"/></hh:bullets>
+  <hh:paraProperties itemCnt="5">
+    <hh:paraPr id="0"><hh:heading type="NONE" idRef="0" level="0"/></hh:paraPr>
+    <hh:paraPr id="1"><hh:heading type="NUMBER" idRef="1" level="0"/></hh:paraPr>
+    <hh:paraPr id="2"><hh:heading type="NUMBER" idRef="1" level="1"/></hh:paraPr>
+    <hh:paraPr id="3"><hh:heading type="NUMBER" idRef="1" level="2"/></hh:paraPr>
+    <hh:paraPr id="4"><hh:heading type="BULLET" idRef="1" level="0"/></hh:paraPr>
+  </hh:paraProperties>
+</hh:refList></hh:head>`
+
+	sectionContent := `<hs:sec xmlns:hs="http://www.hancom.co.kr/hwpml/2011/section"
+        xmlns:hp="http://www.hancom.co.kr/hwpml/2011/paragraph">
+  <hp:p paraPrIDRef="1"><hp:run><hp:t>첫째</hp:t></hp:run></hp:p>
+  <hp:p paraPrIDRef="2"><hp:run><hp:t>하위 항목</hp:t></hp:run></hp:p>
+  <hp:p paraPrIDRef="3"><hp:run><hp:t>세부 항목</hp:t></hp:run></hp:p>
+  <hp:p paraPrIDRef="2"><hp:run><hp:t>하위 항목 2</hp:t></hp:run></hp:p>
+  <hp:p paraPrIDRef="1"><hp:run><hp:t>둘째 </hp:t><hp:edit name="항목"><hp:text>값</hp:text></hp:edit></hp:run></hp:p>
+  <hp:p paraPrIDRef="4"><hp:run><hp:t>글머리표</hp:t></hp:run></hp:p>
+  <hp:p paraPrIDRef="0"><hp:run><hp:t>본문 </hp:t><hp:ctrl><hp:fieldBegin id="7" type="CLICK_HERE" name="성명"/></hp:ctrl><hp:t>홍길동</hp:t><hp:ctrl><hp:fieldEnd beginIDRef="7"/></hp:ctrl></hp:run></hp:p>
+  <hp:p paraPrIDRef="1"><hp:run><hp:t>셋째</hp:t></hp:run></hp:p>
+</hs:sec>`
+
+	header, err := ParseHeader([]byte(headerXML))
+	if err != nil {
+		t.Fatalf("ParseHeader failed: %v", err)
+	}
+	p := &Parser{listHeads: header.ListHeads(), numberings: header.NumberingDefs()}
+	doc := ir.NewDocument()
+	if err := p.parseSectionXML(doc, xml.NewDecoder(strings.NewReader(sectionContent))); err != nil {
+		t.Fatalf("failed to parse section: %v", err)
+	}
+
+	var types []ir.BlockType
+	for _, block := range doc.Content {
+		types = append(types, block.Type)
+	}
+	expected := []ir.BlockType{ir.BlockTypeList, ir.BlockTypeList, ir.BlockTypeParagraph, ir.BlockTypeList}
+	if fmt.Sprint(types) != fmt.Sprint(expected) {
+		t.Fatalf("expected blocks %v, got %v", expected, types)
+	}
+
+	list := doc.Content[0].List
+	if !list.Ordered || list.Start != 1 || list.Format != ir.NumberHangulSyllable || list.Marker != "^1." {
+		t.Errorf("unexpected list: %+v", list)
+	}
+	if len(list.Items) != 2 || list.Items[0].Marker != "가." || list.Items[1].Text != "둘째 값" || list.Items[1].Marker != "나." {
+		t.Fatalf("unexpected items: %+v", list.Items)
+	}
+	sub := list.Items[0].Sublist
+	if sub == nil || sub.Format != ir.NumberCircledDigit || len(sub.Items) != 2 || sub.Items[1].Marker != "②" {
+		t.Fatalf("unexpected sublist: %+v", sub)
+	}
+	third := sub.Items[0].Sublist
+	if third == nil || third.Level != 2 || third.Format != ir.NumberLowerRoman || third.Items[0].Marker != "가.i)" {
+		t.Errorf("unexpected third level: %+v", third)
+	}
+
+	bullets := doc.Content[1].List
+	if bullets.Ordered || bullets.Marker != "•" || bullets.Items[0].Text != "글머리표" {
+		t.Errorf("unexpected bullet list: %+v", bullets)
+	}
+
+	// Numbering continues after the interrupting paragraph
+	rest := doc.Content[3].List
+	if rest.Start != 3 || rest.Items[0].Marker != "다." {
+		t.Errorf("unexpected continued list: %+v", rest)
+	}
+
+	// Form fields point at the blocks holding them after the grouping
+	if len(doc.Fields) != 2 {
+		t.Fatalf("expected 2 form fields, got %d", len(doc.Fields))
+	}
+	if field := doc.Fields[0]; field.Name != "항목" || field.Block != 0 {
+		t.Errorf("expected the list field in block 0, got %+v", field)
+	}
+	if field := doc.Fields[1]; field.Name != "성명" || field.Block != 2 || doc.Content[field.Block].Paragraph == nil {
+		t.Errorf("expected the click-here field in block 2, got %+v", field)
+	}
+}
+
+func TestParseSectionXML_Images(t *testing.T) {
+	sectionContent := `<hs:sec xmlns:hs="http://www.hancom.co.kr/hwpml/2011/section"
+        xmlns:hp="http://www.hancom.co.kr/hwpml/2011/paragraph"
+        xmlns:hc="http://www.hancom.co.kr/hwpml/2011/core">
+  <hp:p><hp:run><hp:tbl rowCnt="1" colCnt="2">
+    <hp:tr>
+      <hp:tc><hp:subList><hp:p><hp:run><hp:t>서명</hp:t></hp:run></hp:p></hp:subList></hp:tc>
+      <hp:tc><hp:subList><hp:p><hp:run><hp:t>(인) </hp:t><hp:pic><hc:img binaryItemIDRef="image1"/></hp:pic></hp:run></hp:p></hp:subList></hp:tc>
+    </hp:tr>
+  </hp:tbl></hp:run></hp:p>
+  <hp:p><hp:run><hp:t>아이콘 </hp:t><hp:pic><hc:img binaryItemIDRef="image2"/></hp:pic><hp:t> 다음 글</hp:t></hp:run></hp:p>
+  <hp:p><hp:run><hp:pic><hc:img binaryItemIDRef="image3"/></hp:pic></hp:run></hp:p>
+</hs:sec>`
+
+	p := &Parser{options: parser.Options{ExtractImages: true}}
+	doc := ir.NewDocument()
+	if err := p.parseSectionXML(doc, xml.NewDecoder(strings.NewReader(sectionContent))); err != nil {
+		t.Fatalf("failed to parse section: %v", err)
+	}
+	if len(doc.Content) != 3 {
+		t.Fatalf("expected table, paragraph and image, got %d blocks", len(doc.Content))
+	}
+
+	// The signature stays in its cell
+	table := doc.Content[0].Table
+	if table == nil {
+		t.Fatalf("expected the table first, got %s", doc.Content[0].Type)
+	}
+	cell := table.Cells[0][1]
+	if cell.Text != "(인) ![image1](image1)" {
+		t.Errorf("unexpected cell text: %q", cell.Text)
+	}
+	if len(cell.Images) != 1 || cell.Images[0].ID != "image1" {
+		t.Errorf("unexpected cell images: %+v", cell.Images)
+	}
+
+	// A picture in the middle of a paragraph becomes an inline run
+	para := doc.Content[1].Paragraph
+	if para == nil || para.Text != "아이콘  다음 글" {
+		t.Fatalf("unexpected paragraph: %+v", doc.Content[1])
+	}
+	if len(para.Runs) != 3 || para.Runs[1].Image == nil || para.Runs[1].Image.ID != "image2" || para.Runs[2].Text != " 다음 글" {
+		t.Errorf("unexpected runs: %+v", para.Runs)
+	}
+
+	// A picture on its own stays a block
+	if img := doc.Content[2].Image; img == nil || img.ID != "image3" {
+		t.Errorf("expected an image block, got %+v", doc.Content[2])
+	}
+}
@@ -0,0 +1,49 @@
+package parser
+
+import "strings"
+
+// HyperlinkURL extracts the URL from a hyperlink field command ("URL;type;...").
+// ':' and ';' in the URL are escaped with '\'. Web addresses without a scheme get "http://".
+func HyperlinkURL(command string) string {
+	return NormalizeLinkURL(fieldTarget(command))
+}
+
+// NormalizeLinkURL adds "http://" to web addresses written without a scheme (e.g. "www.example.com").
+func NormalizeLinkURL(url string) string {
+	url = strings.TrimSpace(url)
+	if strings.HasPrefix(strings.ToLower(url), "www.") {
+		return "http://" + url
+	}
+	return url
+}
+
+// CrossRefTarget extracts the referenced bookmark name from a cross-reference field command
+// or path ("?#name;..."). ':' and ';' are escaped with '\'.
+func CrossRefTarget(command string) string {
+	target := fieldTarget(command)
+	if i := strings.Index(target, "#"); i >= 0 {
+		target = target[i+1:]
+	}
+	return strings.TrimSpace(strings.TrimPrefix(target, "?"))
+}
+
+// fieldTarget returns the first item of a field command, up to the first unescaped ';'.
+func fieldTarget(command string) string {
+	var sb strings.Builder
+	escaped := false
+loop:
+	for _, r := range command {
+		switch {
+		case escaped:
+			sb.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == ';':
+			break loop
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	return strings.TrimSpace(sb.String())
+}
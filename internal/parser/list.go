@@ -0,0 +1,230 @@
+package parser
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/roboco-io/hwp2md/internal/ir"
+)
+
+// ListHead is the paragraph head of a numbered or bulleted paragraph.
+type ListHead struct {
+	Ordered bool            // true = numbered, false = bullet
+	ID      int             // numbering or bullet definition ID
+	Level   int             // level (0-based)
+	Number  int             // number of the paragraph (numbered paragraphs)
+	Format  ir.NumberFormat // number format of the level (numbered paragraphs)
+	Marker  string          // number format string of the level or bullet character
+}
+
+// maxListLevels is the number of levels of a numbering definition.
+const maxListLevels = 7
+
+// ListCounters keeps the current number of each level of each numbering definition.
+// A number of 0 means the level has not started yet.
+type ListCounters map[int]*[maxListLevels]int
+
+// Next advances the number of the given level of a numbering and returns it.
+// start is the first number of the level; lower levels start over after it.
+func (c ListCounters) Next(id, level, start int) int {
+	state, ok := c[id]
+	if !ok {
+		state = &[maxListLevels]int{}
+		c[id] = state
+	}
+	level = min(max(level, 0), maxListLevels-1)
+
+	if state[level] == 0 {
+		state[level] = max(start, 1)
+	} else {
+		state[level]++
+	}
+	for l := level + 1; l < len(state); l++ {
+		state[l] = 0
+	}
+
+	return state[level]
+}
+
+// Numbers returns the current number of each level of a numbering.
+func (c ListCounters) Numbers(id int) [maxListLevels]int {
+	if state, ok := c[id]; ok {
+		return *state
+	}
+	return [maxListLevels]int{}
+}
+
+// ListBuilder groups consecutive list paragraphs into nested list blocks.
+type ListBuilder struct {
+	root      *ir.ListBlock
+	rootLevel int
+	stack     []*ir.ListBlock // current list of each level (stack[0] = root)
+	ids       []int           // numbering or bullet definition ID of each list in stack
+}
+
+// Root returns the list being built, or nil.
+func (b *ListBuilder) Root() *ir.ListBlock {
+	return b.root
+}
+
+// Add appends a list paragraph to the current list.
+// If the paragraph starts a new list, the list built so far is returned.
+func (b *ListBuilder) Add(head ListHead, item ir.ListItem) *ir.ListBlock {
+	var done *ir.ListBlock
+	if b.root != nil && (head.Level < b.rootLevel ||
+		(head.Level == b.rootLevel && (head.Ordered != b.root.Ordered || head.ID != b.ids[0]))) {
+		done = b.Finish()
+	}
+
+	if b.root == nil {
+		b.root = newListBlock(head, 0)
+		b.rootLevel = head.Level
+		b.stack = []*ir.ListBlock{b.root}
+		b.ids = []int{head.ID}
+	}
+
+	depth := head.Level - b.rootLevel
+	if depth > len(b.stack)-1 {
+		// Only one level deeper at a time, as a sublist of the previous item
+		parent := b.stack[len(b.stack)-1]
+		last := &parent.Items[len(parent.Items)-1]
+		if last.Sublist == nil {
+			last.Sublist = newListBlock(head, len(b.stack))
+		}
+		b.stack = append(b.stack, last.Sublist)
+		b.ids = append(b.ids, head.ID)
+		depth = len(b.stack) - 1
+	} else {
+		b.stack = b.stack[:depth+1]
+		b.ids = b.ids[:depth+1]
+		if list := b.stack[depth]; depth > 0 && (head.Ordered != list.Ordered || head.ID != b.ids[depth]) {
+			// A sublist of another kind follows the current one under the same item
+			list.Next = newListBlock(head, depth)
+			b.stack[depth] = list.Next
+			b.ids[depth] = head.ID
+		}
+	}
+
+	item.Level = depth
+	list := b.stack[depth]
+	list.Items = append(list.Items, item)
+
+	return done
+}
+
+// Finish returns the list built so far and resets the builder.
+func (b *ListBuilder) Finish() *ir.ListBlock {
+	root := b.root
+	b.root = nil
+	b.stack = nil
+	b.ids = nil
+	return root
+}
+
+// newListBlock creates a list block starting with the given paragraph.
+func newListBlock(head ListHead, level int) *ir.ListBlock {
+	list := ir.NewList(head.Ordered)
+	list.Level = level
+	list.Marker = head.Marker
+	if head.Ordered {
+		list.Start = head.Number
+		list.Format = head.Format
+	}
+	return list
+}
+
+// NumberMarker resolves a number format string such as "^1." or "(^2)": "^n" is replaced
+// by the number of level n in the format of that level. Levels not started yet show 1.
+func NumberMarker(format string, numbers [maxListLevels]int, formats [maxListLevels]ir.NumberFormat) string {
+	var sb strings.Builder
+	runes := []rune(format)
+	for i := 0; i < len(runes); i++ {
+		if runes[i] == '^' && i+1 < len(runes) && runes[i+1] >= '1' && runes[i+1] <= '7' {
+			level := int(runes[i+1] - '1')
+			sb.WriteString(FormatNumber(max(numbers[level], 1), formats[level]))
+			i++
+			continue
+		}
+		sb.WriteRune(runes[i])
+	}
+	return sb.String()
+}
+
+// Letters of the number formats that count with letters; numbers past the last letter start over.
+var (
+	hangulSyllables = []rune("가나다라마바사아자차카타파하")
+	hangulJamos     = []rune("ㄱㄴㄷㄹㅁㅂㅅㅇㅈㅊㅋㅌㅍㅎ")
+	ideographDigits = []rune("一二三四五六七八九")
+)
+
+// FormatNumber returns n in the given number format (e.g. 3 -> "다", "③", "iii").
+// Numbers the format cannot show are written as digits.
+func FormatNumber(n int, format ir.NumberFormat) string {
+	if n < 1 {
+		return strconv.Itoa(n)
+	}
+	switch format {
+	case ir.NumberCircledDigit:
+		switch {
+		case n <= 20:
+			return string(rune(0x2460 + n - 1)) // ①-⑳
+		case n <= 35:
+			return string(rune(0x3251 + n - 21)) // ㉑-㉟
+		case n <= 50:
+			return string(rune(0x32B1 + n - 36)) // ㊱-㊿
+		}
+	case ir.NumberUpperRoman:
+		return romanNumber(n)
+	case ir.NumberLowerRoman:
+		return strings.ToLower(romanNumber(n))
+	case ir.NumberUpperLatin:
+		return string(rune('A' + (n-1)%26))
+	case ir.NumberLowerLatin:
+		return string(rune('a' + (n-1)%26))
+	case ir.NumberHangulSyllable:
+		return string(hangulSyllables[(n-1)%len(hangulSyllables)])
+	case ir.NumberCircledHangulSyllable:
+		return string(rune(0x326E + (n-1)%14)) // ㉮-㉻
+	case ir.NumberHangulJamo:
+		return string(hangulJamos[(n-1)%len(hangulJamos)])
+	case ir.NumberCircledHangulJamo:
+		return string(rune(0x3260 + (n-1)%14)) // ㉠-㉭
+	case ir.NumberIdeograph:
+		if n < 100 {
+			return ideographNumber(n)
+		}
+	}
+	return strconv.Itoa(n)
+}
+
+// romanNumber returns n (1-3999) in upper case Roman numerals.
+func romanNumber(n int) string {
+	if n >= 4000 {
+		return strconv.Itoa(n)
+	}
+	values := []int{1000, 900, 500, 400, 100, 90, 50, 40, 10, 9, 5, 4, 1}
+	symbols := []string{"M", "CM", "D", "CD", "C", "XC", "L", "XL", "X", "IX", "V", "IV", "I"}
+	var sb strings.Builder
+	for i, v := range values {
+		for n >= v {
+			sb.WriteString(symbols[i])
+			n -= v
+		}
+	}
+	return sb.String()
+}
+
+// ideographNumber returns n (1-99) in ideographs (e.g. 12 -> "十二").
+func ideographNumber(n int) string {
+	var sb strings.Builder
+	if tens := n / 10; tens > 0 {
+		if tens > 1 {
+			sb.WriteRune(ideographDigits[tens-1])
+		}
+		sb.WriteRune('十')
+	}
+	if ones := n % 10; ones > 0 {
+		sb.WriteRune(ideographDigits[ones-1])
+	}
+	return sb.String()
+}
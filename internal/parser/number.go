@@ -0,0 +1,49 @@
+package parser
+
+// NumberKind is the kind of an automatic number (HWP 5.x atno/nwno, HWPX hp:autoNum/hp:newNum).
+// The values match the number kind bits of the HWP 5.x controls.
+type NumberKind int
+
+const (
+	NumberPage NumberKind = iota
+	NumberFootnote
+	NumberEndnote
+	NumberPicture
+	NumberTable
+	NumberEquation
+)
+
+// AutoNumbers resolves the automatic picture, table and equation numbers of captions
+// in reading order, starting from the start numbers set in the document properties.
+// Page, footnote and endnote numbers are laid out by the word processor and are not resolved.
+type AutoNumbers struct {
+	next map[NumberKind]int
+}
+
+// NewAutoNumbers creates counters starting at the given picture, table and equation numbers.
+// A start number of 0 means 1.
+func NewAutoNumbers(picture, table, equation int) *AutoNumbers {
+	return &AutoNumbers{next: map[NumberKind]int{
+		NumberPicture:  max(picture, 1),
+		NumberTable:    max(table, 1),
+		NumberEquation: max(equation, 1),
+	}}
+}
+
+// Next returns the number of the next automatic number of the given kind,
+// or 0 if numbers of that kind are not resolved.
+func (a *AutoNumbers) Next(kind NumberKind) int {
+	n, ok := a.next[kind]
+	if !ok {
+		return 0
+	}
+	a.next[kind] = n + 1
+	return n
+}
+
+// Restart makes the next number of the given kind start at n (a new number control).
+func (a *AutoNumbers) Restart(kind NumberKind, n int) {
+	if _, ok := a.next[kind]; ok && n > 0 {
+		a.next[kind] = n
+	}
+}
@@ -0,0 +1,64 @@
+package parser
+
+import "math"
+
+// PageCounter estimates the page each block starts on from the line layout cached in
+// the document (HWP 5.x PARA_LINE_SEG, HWPX hp:lineseg).
+//
+// The vertical position of a line restarts at the top of every page or column, so a line
+// above the previous one starts a new column, and a new page once all columns are used.
+// Lines flagged as the first line of a page and the first line of a section always start
+// a new page. Objects spanning pages (e.g. long tables) are not measured, so the result
+// is an estimate. The zero value is ready to use.
+type PageCounter struct {
+	page    int
+	column  int
+	columns int
+	lastPos int32
+	started bool // a line was seen since the current section started
+}
+
+// StartSection makes the next line start a new page with the given number of columns.
+func (c *PageCounter) StartSection(columns int) {
+	c.SetColumns(columns)
+	c.started = false
+}
+
+// SetColumns changes the number of columns (a column definition within a section).
+func (c *PageCounter) SetColumns(columns int) {
+	c.columns = max(columns, 1)
+	c.column = 0
+}
+
+// Line records a line at vertical position pos (HWPUNIT from the top of its column).
+// pageStart is set if the document flags the line as the first line of a page
+// or the line follows an explicit page break.
+func (c *PageCounter) Line(pos int32, pageStart bool) {
+	switch {
+	case !c.started || pageStart:
+		c.newPage()
+	case pos < c.lastPos:
+		c.column++
+		if c.column >= max(c.columns, 1) {
+			c.newPage()
+		}
+	}
+	c.lastPos = pos
+}
+
+// newPage moves to the first column of the next page.
+func (c *PageCounter) newPage() {
+	c.page++
+	c.column = 0
+	c.started = true
+}
+
+// Page returns the current page number (1-based), or 0 before the first line.
+func (c *PageCounter) Page() int {
+	return c.page
+}
+
+// HWPUnitToMM converts HWPUNIT (1/7200 inch) to millimeters, rounded to 0.1mm.
+func HWPUnitToMM(v int32) float64 {
+	return math.Round(float64(v)*25.4/7200*10) / 10
+}
@@ -85,14 +85,18 @@ func DetectFormatFromReader(r io.ReaderAt) (Format, error) {
 
 // Options contains parser configuration options.
 type Options struct {
-	ExtractImages bool   // Whether to extract embedded images
-	ImageDir      string // Directory to save extracted images
+	ExtractImages      bool   // Whether to extract embedded images
+	ImageDir           string // Directory to save extracted images
+	ExtractAttachments bool   // Whether to extract embedded OLE objects (spreadsheets, documents)
+	AttachmentDir      string // Directory to save extracted attachments
 }
 
 // DefaultOptions returns default parser options.
 func DefaultOptions() Options {
 	return Options{
-		ExtractImages: false,
-		ImageDir:      "",
+		ExtractImages:      false,
+		ImageDir:           "",
+		ExtractAttachments: false,
+		AttachmentDir:      "",
 	}
 }
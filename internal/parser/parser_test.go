@@ -2,7 +2,10 @@ package parser
 
 import (
 	"bytes"
+	"fmt"
 	"testing"
+
+	"github.com/roboco-io/hwp2md/internal/ir"
 )
 
 func TestDetectFormat(t *testing.T) {
@@ -144,3 +147,295 @@ func TestDefaultOptions(t *testing.T) {
 		t.Error("expected ImageDir to be empty by default")
 	}
 }
+
+func TestPageCounter(t *testing.T) {
+	var c PageCounter
+	if c.Page() != 0 {
+		t.Errorf("expected page 0 before the first line, got %d", c.Page())
+	}
+
+	c.StartSection(1)
+	c.Line(0, false)
+	c.Line(1000, false)
+	if c.Page() != 1 {
+		t.Errorf("expected page 1, got %d", c.Page())
+	}
+
+	// 세로 위치가 다시 작아지면 다음 쪽
+	c.Line(0, false)
+	if c.Page() != 2 {
+		t.Errorf("expected page 2 after vertical position reset, got %d", c.Page())
+	}
+
+	// 2단이면 둘째 단을 채운 뒤에 다음 쪽
+	c.SetColumns(2)
+	c.Line(500, false)
+	c.Line(0, false)
+	if c.Page() != 2 {
+		t.Errorf("expected second column on page 2, got %d", c.Page())
+	}
+	c.Line(300, false)
+	c.Line(0, false)
+	if c.Page() != 3 {
+		t.Errorf("expected page 3, got %d", c.Page())
+	}
+
+	// 쪽의 첫 줄 표시와 새 구역은 새 쪽
+	c.Line(100, true)
+	if c.Page() != 4 {
+		t.Errorf("expected page 4 for flagged line, got %d", c.Page())
+	}
+	c.StartSection(1)
+	c.Line(200, false)
+	if c.Page() != 5 {
+		t.Errorf("expected page 5 for new section, got %d", c.Page())
+	}
+}
+
+func TestHWPUnitToMM(t *testing.T) {
+	if got := HWPUnitToMM(59528); got != 210 {
+		t.Errorf("HWPUnitToMM(59528) = %v, expected 210", got)
+	}
+	if got := HWPUnitToMM(1134); got != 4 {
+		t.Errorf("HWPUnitToMM(1134) = %v, expected 4", got)
+	}
+}
+
+func TestAutoNumbers(t *testing.T) {
+	numbers := NewAutoNumbers(0, 3, 1)
+	if got := numbers.Next(NumberPicture); got != 1 {
+		t.Errorf("first picture = %d, expected 1", got)
+	}
+	if got := numbers.Next(NumberTable); got != 3 {
+		t.Errorf("first table = %d, expected 3", got)
+	}
+	if got := numbers.Next(NumberTable); got != 4 {
+		t.Errorf("second table = %d, expected 4", got)
+	}
+
+	numbers.Restart(NumberTable, 10)
+	if got := numbers.Next(NumberTable); got != 10 {
+		t.Errorf("restarted table = %d, expected 10", got)
+	}
+
+	// Page and note numbers are not resolved
+	if got := numbers.Next(NumberPage); got != 0 {
+		t.Errorf("page number = %d, expected 0", got)
+	}
+}
+
+func TestStyleHeadingLevel(t *testing.T) {
+	tests := []struct {
+		names    []string
+		expected int
+	}{
+		{[]string{"바탕글", "Normal"}, 0},
+		{[]string{"개요 2", "Outline 2"}, 2},
+		{[]string{"사용자 스타일", "Heading 4"}, 4},
+		{[]string{"제목"}, 1},
+		{[]string{"소제목", ""}, 0},
+		{[]string{"양식제목"}, 0},
+	}
+
+	for _, tc := range tests {
+		if got := StyleHeadingLevel(tc.names...); got != tc.expected {
+			t.Errorf("StyleHeadingLevel(%q) = %d, expected %d", tc.names, got, tc.expected)
+		}
+	}
+}
+
+func TestListCounters(t *testing.T) {
+	counters := make(ListCounters)
+	steps := []struct {
+		level    int
+		start    int
+		expected int
+	}{
+		{0, 1, 1},
+		{1, 3, 3},
+		{1, 3, 4},
+		{0, 1, 2},
+		{1, 3, 3}, // lower levels start over after a higher level
+		{9, 1, 1}, // out of range levels count as the last level
+	}
+
+	for i, step := range steps {
+		if got := counters.Next(1, step.level, step.start); got != step.expected {
+			t.Errorf("step %d: expected %d, got %d", i, step.expected, got)
+		}
+	}
+	if numbers := counters.Numbers(1); numbers[0] != 2 || numbers[1] != 3 {
+		t.Errorf("unexpected numbers: %v", numbers)
+	}
+}
+
+func TestListBuilder_Nested(t *testing.T) {
+	var b ListBuilder
+
+	heads := []ListHead{
+		{Ordered: true, ID: 1, Level: 0, Number: 1, Format: ir.NumberHangulSyllable, Marker: "^1."},
+		{Ordered: false, ID: 2, Level: 1, Marker: "-"},
+		{Ordered: false, ID: 2, Level: 1, Marker: "-"},
+		{Ordered: true, ID: 1, Level: 0, Number: 2, Format: ir.NumberHangulSyllable, Marker: "^1."},
+	}
+	for i, head := range heads {
+		if done := b.Add(head, ir.ListItem{Text: fmt.Sprintf("item %d", i)}); done != nil {
+			t.Fatalf("unexpected list break at item %d", i)
+		}
+	}
+
+	// A top-level item of another kind starts a new list
+	done := b.Add(ListHead{Ordered: false, ID: 3, Level: 0}, ir.ListItem{Text: "bullet"})
+	if done == nil {
+		t.Fatal("Expected finished list")
+	}
+
+	if !done.Ordered || done.Start != 1 || len(done.Items) != 2 {
+		t.Fatalf("unexpected root list: %+v", done)
+	}
+	if done.Format != ir.NumberHangulSyllable || done.Marker != "^1." {
+		t.Errorf("unexpected root marker: %q %q", done.Format, done.Marker)
+	}
+	sub := done.Items[0].Sublist
+	if sub == nil || sub.Ordered || sub.Level != 1 || len(sub.Items) != 2 || sub.Marker != "-" {
+		t.Fatalf("unexpected sublist: %+v", sub)
+	}
+	if sub.Items[1].Text != "item 2" || sub.Items[1].Level != 1 {
+		t.Errorf("unexpected sublist item: %+v", sub.Items[1])
+	}
+
+	rest := b.Finish()
+	if rest == nil || rest.Ordered || len(rest.Items) != 1 || b.Root() != nil {
+		t.Errorf("unexpected remaining list: %+v", rest)
+	}
+}
+
+func TestListBuilder_SublistKind(t *testing.T) {
+	var b ListBuilder
+
+	heads := []ListHead{
+		{Ordered: true, ID: 1, Level: 0, Number: 1},
+		{Ordered: false, ID: 2, Level: 1, Marker: "-"},
+		{Ordered: false, ID: 2, Level: 1, Marker: "-"},
+		{Ordered: true, ID: 3, Level: 1, Number: 1, Format: ir.NumberCircledDigit, Marker: "^2"},
+		{Ordered: true, ID: 3, Level: 1, Number: 2, Format: ir.NumberCircledDigit, Marker: "^2"},
+		{Ordered: false, ID: 4, Level: 1, Marker: "*"},
+	}
+	for i, head := range heads {
+		if done := b.Add(head, ir.ListItem{Text: fmt.Sprintf("item %d", i)}); done != nil {
+			t.Fatalf("unexpected list break at item %d", i)
+		}
+	}
+
+	// A level-1 paragraph of another type or numbering starts a new sublist under the same item
+	root := b.Finish()
+	if root == nil || len(root.Items) != 1 {
+		t.Fatalf("unexpected root list: %+v", root)
+	}
+	bullets := root.Items[0].Sublist
+	if bullets == nil || bullets.Ordered || len(bullets.Items) != 2 {
+		t.Fatalf("unexpected first sublist: %+v", bullets)
+	}
+	numbered := bullets.Next
+	if numbered == nil || !numbered.Ordered || numbered.Level != 1 || numbered.Format != ir.NumberCircledDigit || len(numbered.Items) != 2 {
+		t.Fatalf("unexpected second sublist: %+v", numbered)
+	}
+	if numbered.Items[1].Text != "item 4" || numbered.Items[1].Level != 1 {
+		t.Errorf("unexpected sublist item: %+v", numbered.Items[1])
+	}
+	if last := numbered.Next; last == nil || last.Ordered || last.Marker != "*" || len(last.Items) != 1 || last.Next != nil {
+		t.Errorf("unexpected third sublist: %+v", last)
+	}
+}
+
+func TestFormatNumber(t *testing.T) {
+	tests := []struct {
+		n        int
+		format   ir.NumberFormat
+		expected string
+	}{
+		{3, ir.NumberDigit, "3"},
+		{3, "", "3"},
+		{1, ir.NumberCircledDigit, "①"},
+		{20, ir.NumberCircledDigit, "⑳"},
+		{21, ir.NumberCircledDigit, "㉑"},
+		{50, ir.NumberCircledDigit, "㊿"},
+		{51, ir.NumberCircledDigit, "51"},
+		{3, ir.NumberHangulSyllable, "다"},
+		{15, ir.NumberHangulSyllable, "가"},
+		{2, ir.NumberCircledHangulSyllable, "㉯"},
+		{4, ir.NumberHangulJamo, "ㄹ"},
+		{1, ir.NumberCircledHangulJamo, "㉠"},
+		{14, ir.NumberUpperRoman, "XIV"},
+		{1999, ir.NumberLowerRoman, "mcmxcix"},
+		{28, ir.NumberUpperLatin, "B"},
+		{3, ir.NumberLowerLatin, "c"},
+		{10, ir.NumberIdeograph, "十"},
+		{21, ir.NumberIdeograph, "二十一"},
+		{0, ir.NumberHangulSyllable, "0"},
+	}
+
+	for _, tt := range tests {
+		if got := FormatNumber(tt.n, tt.format); got != tt.expected {
+			t.Errorf("FormatNumber(%d, %q) = %q, expected %q", tt.n, tt.format, got, tt.expected)
+		}
+	}
+}
+
+func TestNumberMarker(t *testing.T) {
+	numbers := [7]int{2, 3}
+	formats := [7]ir.NumberFormat{ir.NumberDigit, ir.NumberHangulSyllable, ir.NumberCircledDigit}
+
+	tests := []struct {
+		format   string
+		expected string
+	}{
+		{"^1.", "2."},
+		{"^2)", "다)"},
+		{"(^3)", "(①)"},
+		{"^1.^2.", "2.다."},
+		{"^", "^"},
+		{"^8", "^8"},
+	}
+	for _, tt := range tests {
+		if got := NumberMarker(tt.format, numbers, formats); got != tt.expected {
+			t.Errorf("NumberMarker(%q) = %q, expected %q", tt.format, got, tt.expected)
+		}
+	}
+}
+
+func TestHyperlinkURL(t *testing.T) {
+	tests := []struct {
+		command  string
+		expected string
+	}{
+		{`https\://www.example.com;1;0;0;`, "https://www.example.com"},
+		{`www.example.com;1;0;0;`, "http://www.example.com"},
+		{`http\://a.kr/?q=1\;2;0`, "http://a.kr/?q=1;2"},
+		{`  WWW.example.com  `, "http://WWW.example.com"},
+	}
+
+	for _, tt := range tests {
+		if got := HyperlinkURL(tt.command); got != tt.expected {
+			t.Errorf("HyperlinkURL(%q) = %q, want %q", tt.command, got, tt.expected)
+		}
+	}
+}
+
+func TestCrossRefTarget(t *testing.T) {
+	tests := []struct {
+		command  string
+		expected string
+	}{
+		{`?#별표 3;0;0;0`, "별표 3"},
+		{`#서식\;1;1`, "서식;1"},
+		{` ?#부칙 `, "부칙"},
+		{`책갈피`, "책갈피"},
+	}
+
+	for _, tt := range tests {
+		if got := CrossRefTarget(tt.command); got != tt.expected {
+			t.Errorf("CrossRefTarget(%q) = %q, want %q", tt.command, got, tt.expected)
+		}
+	}
+}